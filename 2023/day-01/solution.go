@@ -0,0 +1,108 @@
+package day01
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2023 day 1.
+type Solver struct{}
+
+// New returns the solver for 2023 day 1.
+func New() solution.Puzzle {
+	return solution.Erase[[]string](Solver{})
+}
+
+// Parse parses the puzzle input's lines.
+func (Solver) Parse(r io.Reader) ([]string, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan file contents: %v", err)
+	}
+	return lines, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(lines []string) (solution.Answer, error) {
+	return solvePt1(lines), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(lines []string) (solution.Answer, error) {
+	return solvePt2(lines), nil
+}
+
+func solvePt1(lines []string) int {
+	sum := 0
+	for _, line := range lines {
+		firstSeen := false
+		first := byte(0x48)
+		last := byte(0x48)
+		for _, elem := range []byte(line) {
+			if elem >= 48 && elem <= 57 {
+				last = elem
+				if !firstSeen {
+					firstSeen = true
+					first = elem
+				}
+			}
+		}
+		num, _ := strconv.Atoi(string([]byte{first, last}))
+		sum += num
+	}
+	return sum
+}
+
+func solvePt2(lines []string) int {
+	stringValues := map[string]byte{
+		"one":   '1',
+		"two":   '2',
+		"three": '3',
+		"four":  '4',
+		"five":  '5',
+		"six":   '6',
+		"seven": '7',
+		"eight": '8',
+		"nine":  '9',
+	}
+
+	sum := 0
+	for _, str := range lines {
+		firstSeen := false
+		first := byte(0x48)
+		last := byte(0x48)
+
+		for i := 0; i < len(str); i++ {
+			for k, v := range stringValues {
+				if strings.HasPrefix(str[i:], k) {
+					last = v
+					if !firstSeen {
+						firstSeen = true
+						first = v
+					}
+				}
+			}
+
+			if byte(str[i]) >= 48 && byte(str[i]) <= 57 {
+				last = byte(str[i])
+				if !firstSeen {
+					firstSeen = true
+					first = byte(str[i])
+				}
+			}
+		}
+
+		num, _ := strconv.Atoi(string([]byte{first, last}))
+		sum += num
+	}
+	return sum
+}
@@ -1,17 +1,54 @@
-package main
+package day04
 
 import (
 	"bufio"
 	"fmt"
-	"log"
-	"os"
+	"io"
 	"strconv"
 	"strings"
 
 	"github.com/adrianosela/adventofcode/utils/set"
 	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
+// Solver solves the puzzle for 2023 day 4.
+type Solver struct{}
+
+// New returns the solver for 2023 day 4.
+func New() solution.Puzzle {
+	return solution.Erase[[]*scratchCard](Solver{})
+}
+
+// Parse parses the puzzle input's scratch cards.
+func (Solver) Parse(r io.Reader) ([]*scratchCard, error) {
+	scanner := bufio.NewScanner(r)
+
+	cards := []*scratchCard{}
+	for scanner.Scan() {
+		sc, err := newScratchCard(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse scratch card: %v", err)
+		}
+		cards = append(cards, sc)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan file contents: %v", err)
+	}
+
+	return cards, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(cards []*scratchCard) (solution.Answer, error) {
+	return solvePart1(cards), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(cards []*scratchCard) (solution.Answer, error) {
+	return solvePart2(cards), nil
+}
+
 type scratchCard struct {
 	winning set.Set[int]
 	numbers []int
@@ -68,51 +105,15 @@ func (s *scratchCard) matches() int {
 	return matches
 }
 
-func solvePart1(filename string) (int, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return 0, fmt.Errorf("failed to open input file: %v", err)
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-
+func solvePart1(cards []*scratchCard) int {
 	sum := 0
-	for scanner.Scan() {
-		sc, err := newScratchCard(scanner.Text())
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse scratch card: %v", err)
-		}
+	for _, sc := range cards {
 		sum += sc.score()
 	}
-	if err := scanner.Err(); err != nil {
-		return 0, fmt.Errorf("failed to scan file contents: %v", err)
-	}
-
-	return sum, nil
+	return sum
 }
 
-func solvePart2(filename string) (int, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return 0, fmt.Errorf("failed to open input file: %v", err)
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-
-	cards := []*scratchCard{}
-	for scanner.Scan() {
-		sc, err := newScratchCard(scanner.Text())
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse scratch card: %v", err)
-		}
-		cards = append(cards, sc)
-	}
-	if err := scanner.Err(); err != nil {
-		return 0, fmt.Errorf("failed to scan file contents: %v", err)
-	}
-
+func solvePart2(cards []*scratchCard) int {
 	// init all card counts to one (the original card)
 	cardCounts := slice.Of(len(cards), 1)
 	totalCards := 0
@@ -123,31 +124,5 @@ func solvePart2(filename string) (int, error) {
 			cardCounts[i+j] += cardCounts[i]
 		}
 	}
-	return totalCards, nil
-}
-
-func main() {
-	sampleSoln, err := solvePart1("sample-input.txt")
-	if err != nil {
-		log.Fatalf("failed to solve part 1 for sample input: %v", err)
-	}
-	log.Printf("[Answer to Small Sample in Part 1] The sum is %d (should be 13)", sampleSoln)
-
-	soln, err := solvePart1("input.txt")
-	if err != nil {
-		log.Fatalf("failed to solve part 1 for input: %v", err)
-	}
-	log.Printf("[Answer to Part 1] The sum is %d", soln)
-
-	sampleSoln2, err := solvePart2("sample-input.txt")
-	if err != nil {
-		log.Fatalf("failed to solve part 2 for sample input: %v", err)
-	}
-	log.Printf("[Answer to Small Sample in Part 2] The number of cards is %d (should be 30)", sampleSoln2)
-
-	soln2, err := solvePart2("input.txt")
-	if err != nil {
-		log.Fatalf("failed to solve part 2 for input: %v", err)
-	}
-	log.Printf("[Answer to Part 2] The number of cards is %d", soln2)
+	return totalCards
 }
@@ -0,0 +1,133 @@
+package day05
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/interval"
+	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2023 day 5.
+type Solver struct{}
+
+// New returns the solver for 2023 day 5.
+func New() solution.Puzzle {
+	return solution.Erase[*input](Solver{})
+}
+
+// Parse parses the puzzle input's seeds and mapping layers.
+func (Solver) Parse(r io.Reader) (*input, error) {
+	return loadInput(r)
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(in *input) (solution.Answer, error) {
+	return solvePart1(in), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(in *input) (solution.Answer, error) {
+	return solvePart2(in), nil
+}
+
+type input struct {
+	seeds []int
+	// layers map the numbers of one category (e.g. seed) to the next (e.g. soil)
+	layers []*interval.RangeMap
+}
+
+func solvePart1(in *input) int {
+	seedToLocation := interval.Chain(in.layers...)
+
+	lowest := int(math.MaxInt)
+	for _, seed := range in.seeds {
+		lowest = min(lowest, seedToLocation.Map(seed))
+	}
+	return lowest
+}
+
+// rather than mapping every seed, the ranges of seeds are mapped through each
+// layer as a whole, being split wherever they straddle a layer's conditions.
+func solvePart2(in *input) int {
+	// in part 2 seeds come in pairs where the first part
+	// is the start and the second is the range
+	current := interval.RangeSet{}
+	for s := 0; s < len(in.seeds)-1; s += 2 {
+		current.Add(interval.Of(in.seeds[s], in.seeds[s+1]))
+	}
+	for _, layer := range in.layers {
+		current = layer.MapSet(current)
+	}
+
+	lowest, ok := current.Min()
+	if !ok {
+		return int(math.MaxInt)
+	}
+	return lowest
+}
+
+func loadInput(r io.Reader) (*input, error) {
+	scanner := bufio.NewScanner(r)
+
+	input := &input{}
+	parsedSeeds := false
+	activeLayer := (*interval.RangeMap)(nil)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if !parsedSeeds {
+			_, after, ok := strings.Cut(line, ":")
+			if !ok {
+				return nil, fmt.Errorf("unexpected seed line (doesnt have ':'): %s", line)
+			}
+			seeds, err := slice.StringsToInts(strings.Fields(after))
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert seed part to integers: %v", err)
+			}
+			input.seeds = seeds
+			parsedSeeds = true
+			continue
+		}
+
+		if len(line) == 0 {
+			if activeLayer != nil {
+				input.layers = append(input.layers, activeLayer)
+			}
+			activeLayer = nil
+			continue
+		}
+
+		if strings.Contains(line, "map") {
+			activeLayer = interval.NewMap()
+			continue
+		}
+
+		if activeLayer == nil {
+			return nil, fmt.Errorf("cond line is not part of any map (missing a map header before it): %s", line)
+		}
+		ints, err := slice.StringsToInts(strings.Fields(line))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert cond line to integers: %v", err)
+		}
+		if len(ints) != 3 {
+			return nil, fmt.Errorf("cond line has more than 3 parts: %s", line)
+		}
+
+		dst, src, size := ints[0], ints[1], ints[2]
+		activeLayer.Add(interval.Of(src, size), dst)
+	}
+	if activeLayer != nil {
+		input.layers = append(input.layers, activeLayer)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan file contents: %v", err)
+	}
+
+	return input, nil
+}
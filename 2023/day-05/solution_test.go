@@ -0,0 +1,27 @@
+package day05
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestMalformedInput(t *testing.T) {
+	for _, in := range []string{
+		"seeds: 79 14\n\n50 98 2\n",
+		"seeds: 79 14\n\nseed-to-soil map:\n50 98 2\n\n52 50 48\n",
+	} {
+		if _, err := loadInput(strings.NewReader(in)); err == nil {
+			t.Errorf("expected an error for a range line without a map header in %q", in)
+		}
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -0,0 +1,201 @@
+package day07
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2023 day 7.
+type Solver struct{}
+
+// New returns the solver for 2023 day 7.
+func New() solution.Puzzle {
+	return solution.Erase[*input](Solver{})
+}
+
+// Parse parses the puzzle input's cards and bids.
+func (Solver) Parse(r io.Reader) (*input, error) {
+	return loadInput(r)
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(in *input) (solution.Answer, error) {
+	return solvePart1(in), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(in *input) (solution.Answer, error) {
+	return solvePart2(in), nil
+}
+
+type input struct {
+	plays []play
+}
+
+type play struct {
+	cards [5]byte
+	bid   int
+}
+
+func (in *input) hands(r *rules) []*hand {
+	hands := make([]*hand, 0, len(in.plays))
+	for _, p := range in.plays {
+		hands = append(hands, newHand(p.cards, p.bid, r))
+	}
+	return hands
+}
+
+type hand struct {
+	cards [5]byte
+	bid   int
+
+	bestHand int
+}
+
+var (
+	handHighCard     = 0
+	handOnePair      = 1
+	handTwoPair      = 2
+	handThreeOfAKind = 3
+	handFullHouse    = 4
+	handFourOfAKind  = 5
+	handFiveOfAKind  = 6
+)
+
+// rules say how hands are ranked: by the order of the
+// cards, and which card (if any) is a wildcard.
+type rules struct {
+	// relativeHandStrength is the strength of each card, used
+	// to break ties between hands of the same type.
+	relativeHandStrength map[byte]int
+	// wildcard acts like whatever card makes the hand strongest, or 0 for none.
+	wildcard byte
+}
+
+// newRules returns the rules with the given cards, weakest first, and wildcard (0 for none).
+func newRules(order string, wildcard byte) *rules {
+	r := &rules{relativeHandStrength: make(map[byte]int), wildcard: wildcard}
+	for strength, card := range []byte(order) {
+		r.relativeHandStrength[card] = strength
+	}
+	return r
+}
+
+var (
+	standardRules = newRules("23456789TJQKA", 0)
+	// in part 2, J cards are jokers, which are wildcards but the weakest cards on their own
+	jokerRules = newRules("J23456789TQKA", 'J')
+)
+
+// bestHand returns the type of the strongest hand the cards make.
+func (r *rules) bestHand(cards [5]byte) int {
+	freq := make(map[byte]int)
+	wildcards := 0
+	for _, card := range cards {
+		if r.wildcard != 0 && card == r.wildcard {
+			wildcards++
+			continue
+		}
+		freq[card]++
+	}
+
+	// the wildcards are best used to add to the largest group of the same card
+	counts := slices.SortedFunc(maps.Values(freq), func(a, b int) int { return b - a })
+	if len(counts) == 0 {
+		counts = []int{0}
+	}
+	counts[0] += wildcards
+
+	switch {
+	case counts[0] == 5:
+		return handFiveOfAKind
+	case counts[0] == 4:
+		return handFourOfAKind
+	case counts[0] == 3 && counts[1] == 2:
+		return handFullHouse
+	case counts[0] == 3:
+		return handThreeOfAKind
+	case counts[0] == 2 && counts[1] == 2:
+		return handTwoPair
+	case counts[0] == 2:
+		return handOnePair
+	default:
+		return handHighCard
+	}
+}
+
+// compare orders hands from weakest to strongest, for use with slices.SortFunc.
+func (r *rules) compare(a, b *hand) int {
+	if a.bestHand != b.bestHand {
+		return cmp.Compare(a.bestHand, b.bestHand)
+	}
+	for i := 0; i < 5; i++ {
+		if c := cmp.Compare(r.relativeHandStrength[a.cards[i]], r.relativeHandStrength[b.cards[i]]); c != 0 {
+			return c
+		}
+	}
+	// they are exactly the same
+	return 0
+}
+
+func newHand(cards [5]byte, bid int, r *rules) *hand {
+	return &hand{
+		cards:    cards,
+		bid:      bid,
+		bestHand: r.bestHand(cards),
+	}
+}
+
+// winnings returns the total winnings, where every hand
+// wins its bid multiplied by its rank (weakest first).
+func winnings(in *input, r *rules) int {
+	hands := in.hands(r)
+	slices.SortFunc(hands, r.compare)
+
+	sum := 0
+	for i, hand := range hands {
+		sum += (hand.bid * (i + 1))
+	}
+	return sum
+}
+
+func solvePart1(in *input) int {
+	return winnings(in, standardRules)
+}
+
+func solvePart2(in *input) int {
+	return winnings(in, jokerRules)
+}
+
+func loadInput(r io.Reader) (*input, error) {
+	scanner := bufio.NewScanner(r)
+
+	input := &input{plays: []play{}}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) > 0 {
+			cardsStr, bidStr, ok := strings.Cut(line, " ")
+			if !ok {
+				return nil, fmt.Errorf("invalid line, no space: %s", line)
+			}
+			bid, err := strconv.Atoi(bidStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert bid to int: %v", err)
+			}
+			input.plays = append(input.plays, play{cards: [5]byte([]byte(cardsStr)), bid: bid})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan file contents: %v", err)
+	}
+
+	return input, nil
+}
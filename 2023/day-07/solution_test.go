@@ -0,0 +1,78 @@
+package day07
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestBestHand(t *testing.T) {
+	tests := []struct {
+		cards          string
+		standard, joke int
+	}{
+		{"23456", handHighCard, handHighCard},
+		{"2345J", handHighCard, handOnePair},
+		{"A23A4", handOnePair, handOnePair},
+		{"A2JA4", handOnePair, handThreeOfAKind},
+		{"23432", handTwoPair, handTwoPair},
+		{"KTJJT", handTwoPair, handFourOfAKind},
+		{"TTT98", handThreeOfAKind, handThreeOfAKind},
+		{"QJJQ2", handTwoPair, handFourOfAKind},
+		{"23332", handFullHouse, handFullHouse},
+		{"2J332", handTwoPair, handFullHouse},
+		{"AA8AA", handFourOfAKind, handFourOfAKind},
+		{"JJJJ2", handFourOfAKind, handFiveOfAKind},
+		{"AAAAA", handFiveOfAKind, handFiveOfAKind},
+		{"JJJJJ", handFiveOfAKind, handFiveOfAKind},
+	}
+	for _, test := range tests {
+		cards := [5]byte([]byte(test.cards))
+		if got := standardRules.bestHand(cards); got != test.standard {
+			t.Errorf("%s without jokers: got hand %d, want %d", test.cards, got, test.standard)
+		}
+		if got := jokerRules.bestHand(cards); got != test.joke {
+			t.Errorf("%s with jokers: got hand %d, want %d", test.cards, got, test.joke)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	hands := func(r *rules, cards ...string) []*hand {
+		hs := []*hand{}
+		for _, c := range cards {
+			hs = append(hs, newHand([5]byte([]byte(c)), 0, r))
+		}
+		return hs
+	}
+	order := func(hs []*hand) []string {
+		cards := []string{}
+		for _, h := range hs {
+			cards = append(cards, string(h.cards[:]))
+		}
+		return cards
+	}
+
+	// ties between hands of the same type are broken by the first different card
+	hs := hands(standardRules, "KK677", "33332", "2AAAA", "KTJJT", "JJJJJ")
+	slices.SortFunc(hs, standardRules.compare)
+	if got, want := order(hs), []string{"KTJJT", "KK677", "2AAAA", "33332", "JJJJJ"}; !slices.Equal(got, want) {
+		t.Errorf("without jokers: got %v, want %v", got, want)
+	}
+
+	// jokers are the weakest card on their own
+	hs = hands(jokerRules, "KK677", "JKKK2", "QQQQ2", "KTJJT", "JJJJJ", "22222")
+	slices.SortFunc(hs, jokerRules.compare)
+	if got, want := order(hs), []string{"KK677", "JKKK2", "QQQQ2", "KTJJT", "JJJJJ", "22222"}; !slices.Equal(got, want) {
+		t.Errorf("with jokers: got %v, want %v", got, want)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
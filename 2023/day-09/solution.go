@@ -0,0 +1,114 @@
+package day09
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2023 day 9.
+type Solver struct{}
+
+// New returns the solver for 2023 day 9.
+func New() solution.Puzzle {
+	return solution.Erase[[][]int](Solver{})
+}
+
+// Parse parses the puzzle input's sequences of values.
+func (Solver) Parse(r io.Reader) ([][]int, error) {
+	scanner := bufio.NewScanner(r)
+
+	sequences := [][]int{}
+	for scanner.Scan() {
+		ints, err := slice.StringsToInts(strings.Fields(scanner.Text()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert line to integers: %v", err)
+		}
+		sequences = append(sequences, ints)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan file contents: %v", err)
+	}
+
+	return sequences, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(sequences [][]int) (solution.Answer, error) {
+	return solvePart1(sequences), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(sequences [][]int) (solution.Answer, error) {
+	return solvePart2(sequences), nil
+}
+
+func solvePart1(sequences [][]int) int {
+	sum := 0
+	for _, ints := range sequences {
+		sum += getNext(ints)
+	}
+	return sum
+}
+
+func getNext(ints []int) int {
+	diffs := make([]int, len(ints)-1)
+
+	refDiff := 0
+	isAllSame := true
+
+	for i := 0; i < len(ints)-1; i++ {
+		diff := ints[i+1] - ints[i]
+		diffs[i] = diff
+		if i == 0 {
+			refDiff = diff
+			continue
+		}
+		if diff != refDiff {
+			isAllSame = false
+		}
+	}
+
+	if !isAllSame {
+		diffs = append(diffs, getNext(diffs))
+	}
+
+	return ints[len(ints)-1] + diffs[len(diffs)-1]
+}
+
+func getPrevious(ints []int) int {
+	diffs := make([]int, len(ints)-1)
+
+	refDiff := 0
+	isAllSame := true
+
+	for i := len(ints) - 1; i >= 1; i-- {
+		diff := ints[i] - ints[i-1]
+		diffs[i-1] = diff
+		if i == len(ints)-1 {
+			refDiff = diff
+			continue
+		}
+		if diff != refDiff {
+			isAllSame = false
+		}
+	}
+
+	if !isAllSame {
+		diffs = append([]int{getPrevious(diffs)}, diffs...)
+	}
+
+	return ints[0] - diffs[0]
+}
+
+func solvePart2(sequences [][]int) int {
+	sum := 0
+	for _, ints := range sequences {
+		sum += getPrevious(ints)
+	}
+	return sum
+}
@@ -0,0 +1,15 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -1,34 +1,59 @@
-package main
+package day01
 
 import (
 	"bufio"
 	"fmt"
-	"log"
-	"os"
+	"io"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
+// Solver solves the puzzle for 2024 day 1.
+type Solver struct{}
+
+// New returns the solver for 2024 day 1.
+func New() solution.Puzzle {
+	return solution.Erase[*input](Solver{})
+}
+
+// Parse parses the puzzle input's two lists of location IDs.
+func (Solver) Parse(r io.Reader) (*input, error) {
+	return parseInput(r, "   ")
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(in *input) (solution.Answer, error) {
+	return part1(in.sorted()), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(in *input) (solution.Answer, error) {
+	return part2(in.sorted()), nil
+}
+
 type input struct {
 	size  int
 	listA []int
 	listB []int
 }
 
-func main() {
+// sorted returns a copy of the input with both lists sorted.
+func (in *input) sorted() *input {
+	sorted := &input{size: in.size, listA: slices.Clone(in.listA), listB: slices.Clone(in.listB)}
+	sort.Slice(sorted.listA, func(i, j int) bool { return sorted.listA[i] < sorted.listA[j] })
+	sort.Slice(sorted.listB, func(i, j int) bool { return sorted.listB[i] < sorted.listB[j] })
+	return sorted
+}
+
+func part1(input *input) int {
 	// For part one, we'll parse the inputs and sort the lists. Then
 	// simply compute the difference between values on the same index
 	// and add all those differences up.
 
-	input, err := parseInput("input.txt", "   ")
-	if err != nil {
-		log.Fatalf("failed to parse input file: %v", err)
-	}
-
-	sort.Slice(input.listA, func(i, j int) bool { return input.listA[i] < input.listA[j] })
-	sort.Slice(input.listB, func(i, j int) bool { return input.listB[i] < input.listB[j] })
-
 	sum := 0
 	for i := 0; i < input.size; i++ {
 		diff := input.listA[i] - input.listB[i]
@@ -37,9 +62,10 @@ func main() {
 		}
 		sum += diff
 	}
+	return sum
+}
 
-	log.Printf("[Answer to Part 1] The sum is: %d", sum)
-
+func part2(input *input) int {
 	// For part two, we'll keep using the sorted lists such that once we know
 	// the index of the first occurrence of a given number, we can simply find
 	// the amount of times that number appears consecutively and stop when we
@@ -64,22 +90,14 @@ func main() {
 
 		similarity += (lookFor * count)
 	}
-
-	log.Printf("[Answer to Part 2] The similarity score is: %d", similarity)
+	return similarity
 }
 
-func parseInput(path string, separator string) (*input, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open input file at path \"%s\": %v", path, err)
-
-	}
-	defer file.Close()
-
+func parseInput(r io.Reader, separator string) (*input, error) {
 	listA := []int{}
 	listB := []int{}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	lines := 0
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -103,7 +121,7 @@ func parseInput(path string, separator string) (*input, error) {
 		listB = append(listB, locationIDB)
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("failed to scan file at path \"%s\": %v", path, err)
+		return nil, fmt.Errorf("failed to scan input: %v", err)
 	}
 
 	return &input{size: lines, listA: listA, listB: listB}, nil
@@ -1,12 +1,13 @@
-package main
+package day02
 
 import (
 	"bufio"
-	"log"
-	"os"
+	"fmt"
+	"io"
 	"strings"
 
 	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
 const (
@@ -15,73 +16,63 @@ const (
 	directionDecreasing = "DECREASING"
 )
 
-func main() {
-	inputPath := "input.txt"
+// Solver solves the puzzle for 2024 day 2.
+type Solver struct{}
 
-	part1(inputPath)
-	part2(inputPath)
+// New returns the solver for 2024 day 2.
+func New() solution.Puzzle {
+	return solution.Erase[[][]int](Solver{})
 }
 
-func part1(inputPath string) {
-	file, err := os.Open(inputPath)
-	if err != nil {
-		log.Fatalf("failed to open input file at path \"%s\": %v", inputPath, err)
-	}
-	defer file.Close()
-
-	safeReports := 0
-	totalReports := 0
+// Parse parses the puzzle input's reports.
+func (Solver) Parse(r io.Reader) ([][]int, error) {
+	reports := [][]int{}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := scanner.Text()
-		totalReports++
 
 		levels, err := slice.StringsToInts(strings.Split(line, " "))
 		if err != nil {
-			log.Fatalf("failed to parse values at line %d \"%s\": %v", totalReports, line, err)
-		}
-
-		if isSafeReport(levels) {
-			safeReports++
+			return nil, fmt.Errorf("failed to parse values at line %d \"%s\": %v", len(reports)+1, line, err)
 		}
+		reports = append(reports, levels)
 	}
 	if err := scanner.Err(); err != nil {
-		log.Fatalf("failed to scan file at path \"%s\": %v", inputPath, err)
+		return nil, fmt.Errorf("failed to scan input: %v", err)
 	}
 
-	log.Printf("[Answer to Part 1] The number of safe reports is: %d/%d", safeReports, totalReports)
+	return reports, nil
 }
 
-func part2(inputPath string) {
-	file, err := os.Open(inputPath)
-	if err != nil {
-		log.Fatalf("failed to open input file at path \"%s\": %v", inputPath, err)
-	}
-	defer file.Close()
-
-	safeReports := 0
-	totalReports := 0
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(reports [][]int) (solution.Answer, error) {
+	return part1(reports), nil
+}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		totalReports++
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(reports [][]int) (solution.Answer, error) {
+	return part2(reports), nil
+}
 
-		levels, err := slice.StringsToInts(strings.Split(line, " "))
-		if err != nil {
-			log.Fatalf("failed to parse values at line %d \"%s\": %v", totalReports, line, err)
+func part1(reports [][]int) int {
+	safeReports := 0
+	for _, levels := range reports {
+		if isSafeReport(levels) {
+			safeReports++
 		}
+	}
+	return safeReports
+}
 
+func part2(reports [][]int) int {
+	safeReports := 0
+	for _, levels := range reports {
 		if isSafeReportWithDampener(levels) {
 			safeReports++
 		}
 	}
-	if err := scanner.Err(); err != nil {
-		log.Fatalf("failed to scan file at path \"%s\": %v", inputPath, err)
-	}
-
-	log.Printf("[Answer to Part 2] The number of safe reports is: %d/%d", safeReports, totalReports)
+	return safeReports
 }
 
 func isSafeReport(levels []int) bool {
@@ -1,13 +1,14 @@
-package main
+package day03
 
 import (
 	"bufio"
 	"fmt"
-	"log"
-	"os"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
 const (
@@ -19,58 +20,55 @@ type input struct {
 	pairs [][]int
 }
 
-func main() {
-	inputPath := "input.txt"
+// Solver solves the puzzle for 2024 day 3.
+type Solver struct{}
 
-	input, err := parseInputForPart1(inputPath)
-	if err != nil {
-		log.Fatalf("failed to parse input file: %v", err)
-	}
+// New returns the solver for 2024 day 3.
+func New() solution.Puzzle {
+	return solution.Erase[[]string](Solver{})
+}
 
-	sum := 0
-	for i := 0; i < len(input.pairs); i++ {
-		mul := 1
-		for j := 0; j < len(input.pairs[i]); j++ {
-			mul *= input.pairs[i][j]
-		}
-		sum += mul
+// Parse parses the lines of the puzzle input's corrupted memory. The
+// instructions are only extracted by each part, as they differ in which
+// instructions are considered.
+func (Solver) Parse(r io.Reader) ([]string, error) {
+	memory := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		memory = append(memory, scanner.Text())
 	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan input: %v", err)
+	}
+	return memory, nil
+}
 
-	log.Printf("[Answer to Part 1] The sum is: %d", sum)
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(memory []string) (solution.Answer, error) {
+	return parseInputForPart1(memory).sumOfProducts(), nil
+}
 
-	input, err = parseInputForPart2(inputPath)
-	if err != nil {
-		log.Fatalf("failed to parse input file: %v", err)
-	}
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(memory []string) (solution.Answer, error) {
+	return parseInputForPart2(memory).sumOfProducts(), nil
+}
 
-	sum = 0
-	for i := 0; i < len(input.pairs); i++ {
+func (in *input) sumOfProducts() int {
+	sum := 0
+	for i := 0; i < len(in.pairs); i++ {
 		mul := 1
-		for j := 0; j < len(input.pairs[i]); j++ {
-			mul *= input.pairs[i][j]
+		for j := 0; j < len(in.pairs[i]); j++ {
+			mul *= in.pairs[i][j]
 		}
 		sum += mul
 	}
-
-	log.Printf("[Answer to Part 2] The sum is: %d", sum)
+	return sum
 }
 
-func parseInputForPart1(path string) (*input, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open input file at path \"%s\": %v", path, err)
-
-	}
-	defer file.Close()
-
+func parseInputForPart1(memory []string) *input {
 	pairs := [][]int{}
 
-	scanner := bufio.NewScanner(file)
-	lines := 0
-	for scanner.Scan() {
-		line := scanner.Text()
-		lines++
-
+	for _, line := range memory {
 		parts := strings.Split(line, "mul(")
 
 		// valid instructions should be parts of the form
@@ -103,32 +101,17 @@ func parseInputForPart1(path string) (*input, error) {
 			pairs = append(pairs, []int{firstInt, secondInt})
 		}
 	}
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("failed to scan file at path \"%s\": %v", path, err)
-	}
 
-	return &input{pairs: pairs}, nil
+	return &input{pairs: pairs}
 }
 
-func parseInputForPart2(path string) (*input, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open input file at path \"%s\": %v", path, err)
-
-	}
-	defer file.Close()
-
+func parseInputForPart2(memory []string) *input {
 	re := regexp.MustCompile(`mul\(\d+,\d+\)|do\(\)|don't\(\)`)
 	enabled := true
 
-	scanner := bufio.NewScanner(file)
-	lines := 0
 	pairs := [][]int{}
-	for scanner.Scan() {
-		line := scanner.Bytes()
-		lines++
-
-		matches := re.FindAll(line, -1)
+	for _, line := range memory {
+		matches := re.FindAll([]byte(line), -1)
 		for _, match := range matches {
 			matchStr := string(match)
 
@@ -176,9 +159,6 @@ func parseInputForPart2(path string) (*input, error) {
 			}
 		}
 	}
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("failed to scan file at path \"%s\": %v", path, err)
-	}
 
-	return &input{pairs: pairs}, nil
+	return &input{pairs: pairs}
 }
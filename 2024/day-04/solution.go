@@ -0,0 +1,160 @@
+package day04
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	charM = 'M'
+	charA = 'A'
+	charS = 'S'
+)
+
+// Solver solves the puzzle for 2024 day 4.
+type Solver struct {
+	Debug bool
+}
+
+// New returns the solver for 2024 day 4.
+func New() solution.Puzzle {
+	return solution.Erase[grid.Grid[byte]](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+}
+
+// Parse parses the puzzle input's word search grid.
+func (s *Solver) Parse(r io.Reader) (grid.Grid[byte], error) {
+	g, err := grid.ReadByte(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load grid from input: %v", err)
+	}
+	return g, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(g grid.Grid[byte]) (solution.Answer, error) {
+	return findString(g, "XMAS", s.Debug), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(g grid.Grid[byte]) (solution.Answer, error) {
+	return findCrossedMASes(g, s.Debug), nil
+}
+
+func findCrossedMASes(g grid.Grid[byte], debug bool) int {
+	occurrences := 0
+	for y := range g {
+		for x := range g[y] {
+			if g[y][x] == charA {
+				cNW := getCharInDirection(g, y, x, grid.NorthWest)
+				cNE := getCharInDirection(g, y, x, grid.NorthEast)
+				cSW := getCharInDirection(g, y, x, grid.SouthWest)
+				cSE := getCharInDirection(g, y, x, grid.SouthEast)
+				// m northwest, s northeast, m southwest, s southeast
+				if cNW == charM && cNE == charS && cSW == charM && cSE == charS {
+					occurrences++
+					continue
+				}
+				// m northwest, m northeast, s southwest, s southeast
+				if cNW == charM && cNE == charM && cSW == charS && cSE == charS {
+					occurrences++
+					continue
+				}
+				// s northwest, m northeast, s southwest, m southeast
+				if cNW == charS && cNE == charM && cSW == charS && cSE == charM {
+					occurrences++
+					continue
+				}
+				// s northwest, s northeast, m southwest, m southeast
+				if cNW == charS && cNE == charS && cSW == charM && cSE == charM {
+					occurrences++
+					continue
+				}
+			}
+		}
+	}
+	return occurrences
+}
+
+func findString(g grid.Grid[byte], str string, debug bool) int {
+	if len(str) == 0 {
+		return 0
+	}
+
+	occurrences := 0
+	for y := range g {
+		for x := range g[y] {
+			if g[y][x] == str[0] {
+				for _, direction := range grid.Directions8 {
+					if match(g, y, x, str, 1, direction, y, x, debug) {
+						occurrences++
+					}
+				}
+			}
+		}
+	}
+	return occurrences
+}
+
+func getCharInDirection(
+	g grid.Grid[byte],
+	posY int,
+	posX int,
+	direction grid.Direction,
+) byte {
+	char, ok := g.Get(grid.Coordinate{X: posX, Y: posY}.Move(direction))
+	if !ok {
+		return '.' // represents nil/invalid
+	}
+	return char
+}
+
+func match(
+	g grid.Grid[byte],
+	posY int,
+	posX int,
+	find string,
+	lookForPos int,
+	direction grid.Direction,
+	startY int, // used for debugging
+	startX int, // used for debugging
+	debug bool, // used for debugging
+) bool {
+	if lookForPos == len(find) {
+		if debug {
+			log.Printf(
+				"found %s from (y=%d,x=%d) to (y=%d,x=%d) (%s direction)",
+				find,
+				startY,
+				startX,
+				posY,
+				posX,
+				direction,
+			)
+		}
+		return true
+	}
+
+	// calculate movement based on direction
+	offset := direction.Offset()
+	newX := posX + offset.X
+	newY := posY + offset.Y
+
+	// if the new position is out of the grid or does not
+	// match the expected character, return early.
+	if char, ok := g.Get(grid.Coordinate{X: newX, Y: newY}); !ok || char != find[lookForPos] {
+		return false
+	}
+
+	// if it does match, keep matching.
+	return match(g, newY, newX, find, lookForPos+1, direction, startY, startX, debug)
+}
@@ -0,0 +1,120 @@
+package day05
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/poset"
+	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2024 day 5.
+type Solver struct{}
+
+// New returns the solver for 2024 day 5.
+func New() solution.Puzzle {
+	return solution.Erase[*input](Solver{})
+}
+
+type input struct {
+	rules   *poset.Poset[int]
+	updates [][]int
+}
+
+// Parse parses the puzzle input's page ordering rules and updates.
+func (Solver) Parse(r io.Reader) (*input, error) {
+	rules, updates, err := loadInput(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load inputs: %v", err)
+	}
+	return &input{rules: rules, updates: updates}, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(in *input) (solution.Answer, error) {
+	return part1(in.rules, in.updates), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(in *input) (solution.Answer, error) {
+	return part2(in.rules, in.updates)
+}
+
+func part2(rules *poset.Poset[int], updates [][]int) (int, error) {
+	sum := 0
+	for u := 0; u < len(updates); u++ {
+		if !rules.IsSorted(updates[u]) {
+			update, err := rules.Sort(updates[u])
+			if err != nil {
+				return 0, fmt.Errorf("failed to correct the order of update %d: %v", u, err)
+			}
+			sum += update[len(update)/2]
+		}
+	}
+	return sum, nil
+}
+
+func part1(rules *poset.Poset[int], updates [][]int) int {
+	sum := 0
+	for u := 0; u < len(updates); u++ {
+		if rules.IsSorted(updates[u]) {
+			sum += updates[u][len(updates[u])/2]
+		}
+	}
+	return sum
+}
+
+func loadInput(r io.Reader) (*poset.Poset[int], [][]int, error) {
+	scanner := bufio.NewScanner(r)
+
+	// start by loading rules until we reach
+	// an empty line, then we start loading updates.
+	loadingRules := true
+
+	rules := poset.New[int]()
+	updates := make([][]int, 0)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if loadingRules {
+				loadingRules = false
+			}
+			continue
+		}
+
+		if loadingRules {
+			beforeStr, afterStr, ok := strings.Cut(line, "|")
+			if !ok {
+				return nil, nil, fmt.Errorf("invalid input while loading rules (does not contain delimeter '|'): \"%s\"", line)
+			}
+
+			before, err := strconv.Atoi(beforeStr)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid input while loading rules (first part not an integer): \"%s\"", line)
+			}
+
+			after, err := strconv.Atoi(afterStr)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid input while loading rules (second part not an integer): \"%s\"", line)
+			}
+
+			rules.Add(before, after)
+
+			continue
+		}
+
+		ints, err := slice.StringsToInts(strings.Split(line, ","))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to convert update line to a slice of integers: %v", err)
+		}
+		updates = append(updates, ints)
+	}
+
+	return rules, updates, nil
+}
@@ -0,0 +1,285 @@
+package day06
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/adrianosela/adventofcode/utils/bitset"
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/render"
+	"github.com/adrianosela/adventofcode/utils/set"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	guardIndicator    = '^'
+	spaceIndicator    = '.'
+	obstacleIndicator = '#'
+)
+
+// Solver solves the puzzle for 2024 day 6.
+type Solver struct {
+	GIF       string
+	Workers   int
+	Obstacles string
+}
+
+// New returns the solver for 2024 day 6.
+func New() solution.Puzzle {
+	return solution.Erase[grid.Grid[byte]](&Solver{Workers: runtime.NumCPU()})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.StringVar(&s.GIF, "gif", "", "A file to draw an animation of the guard's walk in part 1 to")
+	fs.IntVar(&s.Workers, "workers", runtime.NumCPU(), "How many obstacle positions to try at the same time in part 2")
+	fs.StringVar(&s.Obstacles, "obstacles", "", "A file to write the positions of the obstacles which make the guard walk in a loop in part 2 to, or \"-\" for stdout")
+}
+
+// Parse parses the puzzle input's map of the lab.
+func (s *Solver) Parse(r io.Reader) (grid.Grid[byte], error) {
+	g, err := grid.ReadByte(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load grid: %v", err)
+	}
+	return g, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(g grid.Grid[byte]) (solution.Answer, error) {
+	path, err := guardPath(g)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]grid.Coordinate, len(path))
+	for i, st := range path {
+		positions[i] = st.position
+	}
+	if s.GIF != "" {
+		if err := drawWalk(s.GIF, g, positions); err != nil {
+			return nil, fmt.Errorf("failed to draw the guard's walk: %v", err)
+		}
+	}
+	return set.New(positions...).Size(), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(g grid.Grid[byte]) (solution.Answer, error) {
+	path, err := guardPath(g)
+	if err != nil {
+		return nil, err
+	}
+
+	obstacles := loopObstacles(g, path, max(1, s.Workers))
+	if s.Obstacles != "" {
+		if err := s.reportObstacles(obstacles); err != nil {
+			return nil, fmt.Errorf("failed to write the obstacles: %v", err)
+		}
+	}
+	return len(obstacles), nil
+}
+
+// reportObstacles writes the positions of the obstacles to the configured file, or stdout for "-".
+func (s *Solver) reportObstacles(obstacles []grid.Coordinate) error {
+	if s.Obstacles == "-" {
+		return writeObstacles(os.Stdout, obstacles)
+	}
+	f, err := os.Create(s.Obstacles)
+	if err != nil {
+		return fmt.Errorf("failed to create obstacles file: %v", err)
+	}
+	defer f.Close()
+	if err := writeObstacles(f, obstacles); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// writeObstacles writes the positions of the obstacles to w, as "x,y" on a line each.
+func writeObstacles(w io.Writer, obstacles []grid.Coordinate) error {
+	for _, c := range obstacles {
+		if _, err := fmt.Fprintf(w, "%d,%d\n", c.X, c.Y); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findGuard(g grid.Grid[byte]) (grid.Coordinate, bool) {
+	for c, v := range g.All() {
+		if v == guardIndicator {
+			return c, true
+		}
+	}
+	return grid.Coordinate{}, false
+}
+
+// guardPath returns the guard's path through the lab, from where they start until they leave it.
+func guardPath(g grid.Grid[byte]) ([]step, error) {
+	start, ok := findGuard(g)
+	if !ok {
+		return nil, fmt.Errorf("grid did not contain guard indicator (%c)", guardIndicator)
+	}
+	path, loops := walk(g, start)
+	if loops {
+		return nil, fmt.Errorf("the guard walks in a loop without ever leaving the lab")
+	}
+	return path, nil
+}
+
+// step is a position of the guard on their patrol, along with the direction they were
+// heading to get there (or, for where they start, the direction they are facing).
+type step struct {
+	position grid.Coordinate
+	heading  grid.Direction
+}
+
+// walk returns the steps of the guard in order, from where they start (facing north) until
+// they are about to leave the lab, and true when they end up walking in a loop instead.
+func walk(g grid.Grid[byte], start grid.Coordinate) ([]step, bool) {
+	path := []step{{position: start, heading: grid.North}}
+	loops := patrol(g, path[0], newStates(g), func(st step) {
+		path = append(path, st)
+	})
+	return path, loops
+}
+
+// states has a bit for each position and (orthogonal) heading of the guard in a lab.
+type states struct {
+	width int
+	bits  bitset.Bitset
+}
+
+func newStates(g grid.Grid[byte]) *states {
+	return &states{width: g.Width(), bits: bitset.New(g.Width() * g.Height() * 4)}
+}
+
+// visit marks the guard's position and heading as visited, returning true when they already were.
+func (s *states) visit(c grid.Coordinate, heading grid.Direction) bool {
+	return s.bits.Visit((c.Y*s.width+c.X)*4 + int(heading)/2)
+}
+
+func (s *states) reset() {
+	s.bits.Clear()
+}
+
+// patrol moves the guard from the given step (facing its heading), calling visit (unless nil)
+// with every step they take, until they leave the lab. It returns true when they walk in a
+// loop instead, i.e. when they are at the same position facing the same way a second time.
+func patrol(g grid.Grid[byte], from step, seen *states, visit func(step)) bool {
+	position, dir := from.position, from.heading
+	for {
+		if seen.visit(position, dir) {
+			return true
+		}
+		next := position.Move(dir)
+		v, ok := g.Get(next)
+		if !ok {
+			return false
+		}
+		if v == obstacleIndicator {
+			// keep the same coordinates, just change direction
+			dir = dir.TurnRight()
+			continue
+		}
+		position = next
+		if visit != nil {
+			visit(step{position: position, heading: dir})
+		}
+	}
+}
+
+// loopObstacles returns the positions (row by row) where a single new obstacle makes the guard walk in
+// a loop, given their path without it. Only positions on the path can change where the guard goes, and
+// they walk the same path up until they first reach it, so the patrol for each starts from right before
+// there. The positions are tried by the given number of workers, each with their own copy of the lab.
+func loopObstacles(g grid.Grid[byte], path []step, workers int) []grid.Coordinate {
+	// the guard starts where the first step is, so it can't have an obstacle
+	type candidate struct {
+		obstacle grid.Coordinate
+		from     step
+	}
+	candidates := make(chan candidate)
+	go func() {
+		defer close(candidates)
+		tried := set.New(path[0].position)
+		for i := 1; i < len(path); i++ {
+			if tried.Has(path[i].position) {
+				continue
+			}
+			tried.Put(path[i].position)
+			candidates <- candidate{
+				obstacle: path[i].position,
+				from:     step{position: path[i-1].position, heading: path[i].heading},
+			}
+		}
+	}()
+
+	found := make([][]grid.Coordinate, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lab, seen := g.Clone(), newStates(g)
+			for c := range candidates {
+				original, _ := lab.Get(c.obstacle)
+				lab.Set(c.obstacle, obstacleIndicator)
+				seen.reset()
+				if patrol(lab, c.from, seen, nil) {
+					found[w] = append(found[w], c.obstacle)
+				}
+				lab.Set(c.obstacle, original)
+			}
+		}()
+	}
+	wg.Wait()
+
+	obstacles := slices.Concat(found...)
+	slices.SortFunc(obstacles, func(a, b grid.Coordinate) int {
+		return cmp.Or(cmp.Compare(a.Y, b.Y), cmp.Compare(a.X, b.X))
+	})
+	return obstacles
+}
+
+// drawWalk draws the guard walking the given path through the lab as an animated GIF in the given file.
+func drawWalk(filename string, g grid.Grid[byte], path []grid.Coordinate) error {
+	anim := &render.Animation{
+		Style: render.Style{
+			Colors:  map[byte]color.Color{obstacleIndicator: color.RGBA{R: 160, G: 160, B: 160, A: 255}},
+			Default: color.Black,
+		},
+		Scale: 4,
+		Delay: 4,
+	}
+	visited := color.RGBA{R: 40, G: 90, B: 200, A: 255}
+	guard := color.RGBA{R: 255, G: 60, B: 60, A: 255}
+
+	// a frame for every step would make for a huge file, so there are at most 200
+	step := max(1, len(path)/200)
+	for i := 0; i < len(path); i += step {
+		anim.Frame(g,
+			render.Overlay{Cells: path[:i+1], Color: visited},
+			render.Overlay{Cells: path[i : i+1], Color: guard},
+		)
+	}
+	anim.Frame(g, render.Overlay{Cells: path, Color: visited}, render.Overlay{Cells: path[len(path)-1:], Color: guard})
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create animation file: %v", err)
+	}
+	defer f.Close()
+	if err := anim.Encode(f); err != nil {
+		return err
+	}
+	return f.Close()
+}
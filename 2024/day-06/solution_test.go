@@ -0,0 +1,65 @@
+package day06
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestLoopObstacles(t *testing.T) {
+	f, err := os.Open("sample-input.txt")
+	if err != nil {
+		t.Fatalf("failed to open sample input: %v", err)
+	}
+	defer f.Close()
+	g, err := grid.ReadByte(f)
+	if err != nil {
+		t.Fatalf("failed to read sample input: %v", err)
+	}
+	path, err := guardPath(g)
+	if err != nil {
+		t.Fatalf("failed to walk the sample: %v", err)
+	}
+
+	want := []grid.Coordinate{{X: 3, Y: 6}, {X: 6, Y: 7}, {X: 7, Y: 7}, {X: 1, Y: 8}, {X: 3, Y: 8}, {X: 7, Y: 9}}
+	for _, workers := range []int{1, 4} {
+		if got := loopObstacles(g, path, workers); !slices.Equal(got, want) {
+			t.Errorf("with %d workers got %v, want %v", workers, got, want)
+		}
+	}
+
+	var sb strings.Builder
+	if err := writeObstacles(&sb, want); err != nil {
+		t.Fatalf("failed to write obstacles: %v", err)
+	}
+	if got := sb.String(); got != "3,6\n6,7\n7,7\n1,8\n3,8\n7,9\n" {
+		t.Errorf("got written obstacles %q", got)
+	}
+}
+
+func TestWalkLoops(t *testing.T) {
+	// a guard boxed in by obstacles turns in place forever
+	g := grid.Grid[byte]{
+		[]byte(".#."),
+		[]byte("#^#"),
+		[]byte(".#."),
+	}
+	if _, loops := walk(g, grid.Coordinate{X: 1, Y: 1}); !loops {
+		t.Error("expected a boxed in guard to loop")
+	}
+	if _, err := guardPath(g); err == nil {
+		t.Error("expected an error for a guard who never leaves")
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
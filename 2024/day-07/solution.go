@@ -0,0 +1,114 @@
+package day07
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2024 day 7.
+type Solver struct{}
+
+// New returns the solver for 2024 day 7.
+func New() solution.Puzzle {
+	return solution.Erase[[]equation](Solver{})
+}
+
+type equation struct {
+	total    int
+	operands []int
+}
+
+// Parse parses the puzzle input's calibration equations.
+func (Solver) Parse(r io.Reader) ([]equation, error) {
+	equations := []equation{}
+	scanner := bufio.NewScanner(r)
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+
+		totalStr, operandsStr, ok := strings.Cut(line, ": ")
+		if !ok {
+			return nil, fmt.Errorf("invalid input on line %d: does not contain colon", lineNo)
+		}
+
+		total, err := strconv.Atoi(totalStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input on line %d: first part not an integer", lineNo)
+		}
+
+		operands, err := slice.StringsToInts(strings.Split(operandsStr, " "))
+		if err != nil {
+			return nil, fmt.Errorf("invalid input on line %d: %v", lineNo, err)
+		}
+
+		equations = append(equations, equation{total: total, operands: operands})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan input: %v", err)
+	}
+
+	return equations, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(equations []equation) (solution.Answer, error) {
+	return part1(equations), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(equations []equation) (solution.Answer, error) {
+	return part2(equations), nil
+}
+
+func part1(equations []equation) int {
+	sum := 0
+	for _, eq := range equations {
+		if len(eq.operands) == 0 {
+			continue
+		}
+		if valid(eq.total, eq.operands[0], eq.operands[1:]...) {
+			sum += eq.total
+		}
+	}
+	return sum
+}
+
+func valid(total int, val int, operands ...int) bool {
+	if len(operands) == 0 {
+		return total == val
+	}
+	return valid(total, val+operands[0], operands[1:]...) ||
+		valid(total, val*operands[0], operands[1:]...)
+}
+
+func part2(equations []equation) int {
+	sum := 0
+	for _, eq := range equations {
+		if len(eq.operands) == 0 {
+			continue
+		}
+		if validWithConcat(eq.total, eq.operands[0], eq.operands[1:]...) {
+			sum += eq.total
+		}
+	}
+	return sum
+}
+
+func validWithConcat(total int, val int, operands ...int) bool {
+	if len(operands) == 0 {
+		return total == val
+	}
+	return validWithConcat(total, val+operands[0], operands[1:]...) ||
+		validWithConcat(total, val*operands[0], operands[1:]...) ||
+		validWithConcat(total, concat(val, operands[0]), operands[1:]...)
+}
+
+func concat(a, b int) int {
+	result, _ := strconv.Atoi(fmt.Sprintf("%s%s", strconv.Itoa(a), strconv.Itoa(b)))
+	return result
+}
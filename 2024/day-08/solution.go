@@ -0,0 +1,164 @@
+package day08
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"slices"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/numtheory"
+	"github.com/adrianosela/adventofcode/utils/render"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	emptyIndicator    = '.'
+	antinodeIndicator = '#'
+)
+
+// Solver solves the puzzle for 2024 day 8.
+type Solver struct {
+	Debug  bool
+	Render bool
+}
+
+// New returns the solver for 2024 day 8.
+func New() solution.Puzzle {
+	return solution.Erase[grid.Grid[byte]](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+	fs.BoolVar(&s.Render, "render", false, "Whether to print the map with the antinodes marked on it")
+}
+
+// Parse parses the puzzle input's map of antennas.
+func (s *Solver) Parse(r io.Reader) (grid.Grid[byte], error) {
+	g, err := grid.ReadByte(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load grid: %v", err)
+	}
+	return g, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(g grid.Grid[byte]) (solution.Answer, error) {
+	return s.solve(g, mirrored), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(g grid.Grid[byte]) (solution.Answer, error) {
+	return s.solve(g, harmonics), nil
+}
+
+func (s *Solver) solve(g grid.Grid[byte], model antinodeModel) int {
+	antinodes := findAntinodes(g, groupAntennas(g), model)
+	if s.Debug {
+		for _, c := range antinodes.Coordinates() {
+			for _, p := range antinodes[c] {
+				log.Printf("Found antinode of %s and %s: %s", p[0], p[1], c)
+			}
+		}
+	}
+	if s.Render {
+		fmt.Print(antinodes.render(g))
+	}
+	return antinodes.Size()
+}
+
+// groupAntennas returns the positions of the antennas of each frequency, which
+// is any character but an empty cell (or an antinode marked on the map).
+func groupAntennas(g grid.Grid[byte]) map[byte][]grid.Coordinate {
+	antennas := make(map[byte][]grid.Coordinate)
+	for c, v := range g.All() {
+		if v != emptyIndicator && v != antinodeIndicator {
+			antennas[v] = append(antennas[v], c)
+		}
+	}
+	return antennas
+}
+
+// pair is two antennas of the same frequency.
+type pair [2]grid.Coordinate
+
+// antinodeSet holds the antinodes on a map, along with the pairs of antennas which make each of them.
+type antinodeSet map[grid.Coordinate][]pair
+
+// Size returns the number of distinct antinodes.
+func (s antinodeSet) Size() int {
+	return len(s)
+}
+
+// Coordinates returns the antinodes row by row.
+func (s antinodeSet) Coordinates() []grid.Coordinate {
+	return slices.SortedFunc(maps.Keys(s), func(a, b grid.Coordinate) int {
+		return cmp.Or(cmp.Compare(a.Y, b.Y), cmp.Compare(a.X, b.X))
+	})
+}
+
+// render returns the map with the antinodes marked on it, except where they are on an antenna.
+func (s antinodeSet) render(g grid.Grid[byte]) string {
+	marked := []grid.Coordinate{}
+	for _, c := range s.Coordinates() {
+		if v, _ := g.Get(c); v == emptyIndicator {
+			marked = append(marked, c)
+		}
+	}
+	return render.Text(g, render.Overlay{Cells: marked, Glyph: antinodeIndicator})
+}
+
+// antinodeModel returns the antinodes (within the map) of a pair of antennas.
+type antinodeModel func(g grid.Grid[byte], p pair) []grid.Coordinate
+
+// mirrored are the antinodes of part 1: the points in line with the antennas
+// which are twice as far from one antenna as from the other.
+func mirrored(g grid.Grid[byte], p pair) []grid.Coordinate {
+	diff := p[1].Sub(p[0])
+	antinodes := []grid.Coordinate{}
+	for _, c := range []grid.Coordinate{p[0].Sub(diff), p[1].Add(diff)} {
+		if g.InBounds(c) {
+			antinodes = append(antinodes, c)
+		}
+	}
+	return antinodes
+}
+
+// harmonics are the antinodes of part 2: every grid point exactly in line with the antennas
+// (including the antennas themselves). The smallest step between such points is the offset
+// between the antennas divided by the gcd of its components.
+func harmonics(g grid.Grid[byte], p pair) []grid.Coordinate {
+	diff := p[1].Sub(p[0])
+	gcd := numtheory.GCD(diff.X, diff.Y)
+	step := grid.Coordinate{X: diff.X / gcd, Y: diff.Y / gcd}
+
+	antinodes := []grid.Coordinate{}
+	for c := p[0]; g.InBounds(c); c = c.Sub(step) {
+		antinodes = append(antinodes, c)
+	}
+	for c := p[0].Add(step); g.InBounds(c); c = c.Add(step) {
+		antinodes = append(antinodes, c)
+	}
+	return antinodes
+}
+
+// findAntinodes returns the antinodes of every pair of antennas of the same frequency.
+func findAntinodes(g grid.Grid[byte], antennas map[byte][]grid.Coordinate, model antinodeModel) antinodeSet {
+	antinodes := make(antinodeSet)
+	for _, frequency := range slices.Sorted(maps.Keys(antennas)) {
+		positions := antennas[frequency]
+		for i := range positions {
+			for j := i + 1; j < len(positions); j++ {
+				p := pair{positions[i], positions[j]}
+				for _, c := range model(g, p) {
+					antinodes[c] = append(antinodes[c], p)
+				}
+			}
+		}
+	}
+	return antinodes
+}
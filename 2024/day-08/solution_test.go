@@ -0,0 +1,65 @@
+package day08
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestRender(t *testing.T) {
+	g := grid.Grid[byte]{}
+	for _, row := range strings.Fields(".......... .......... .......... ....a..... ........a. .....a.... .......... ......A... .......... ..........") {
+		g = append(g, []byte(row))
+	}
+	antinodes := findAntinodes(g, groupAntennas(g), mirrored)
+
+	// the antinode on the lone A antenna counts, but is not drawn over it
+	want := strings.Join([]string{
+		"..........",
+		"...#......",
+		"#.........",
+		"....a.....",
+		"........a.",
+		".....a....",
+		"..#.......",
+		"......A...",
+		"..........",
+		"..........",
+	}, "\n") + "\n"
+	if got := antinodes.render(g); got != want {
+		t.Errorf("got map\n%s\nwant\n%s", got, want)
+	}
+	if antinodes.Size() != 4 {
+		t.Errorf("got %d antinodes, want 4", antinodes.Size())
+	}
+	if got, want := antinodes[grid.Coordinate{X: 6, Y: 7}], []pair{{{X: 4, Y: 3}, {X: 5, Y: 5}}}; !slices.Equal(got, want) {
+		t.Errorf("got pairs %v for the antinode on A, want %v", got, want)
+	}
+}
+
+func TestHarmonics(t *testing.T) {
+	g := grid.Grid[byte]{
+		[]byte("a...."),
+		[]byte("....."),
+		[]byte("....a"),
+		[]byte("....."),
+		[]byte("....."),
+	}
+	// the antennas are two apart vertically and four horizontally, so halfway between them is in line too
+	got := findAntinodes(g, groupAntennas(g), harmonics).Coordinates()
+	want := []grid.Coordinate{{X: 0, Y: 0}, {X: 2, Y: 1}, {X: 4, Y: 2}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got antinodes %v, want %v", got, want)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -0,0 +1,229 @@
+package day10
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/bitset"
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/set"
+	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2024 day 10.
+type Solver struct {
+	TrailStart int
+	TrailEnd   int
+	Diagonal   bool
+	Steps      string
+	Debug      bool
+}
+
+// New returns the solver for 2024 day 10.
+func New() solution.Puzzle {
+	return solution.Erase[grid.Grid[int]](&Solver{TrailStart: 0, TrailEnd: 9, Steps: "+1"})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+	fs.IntVar(&s.TrailStart, "trail-start", 0, "Value indicating start of the trail")
+	fs.IntVar(&s.TrailEnd, "trail-end", 9, "Value indicating end of the trail")
+	fs.BoolVar(&s.Diagonal, "diagonal", false, "Whether trails can also take diagonal steps")
+	fs.StringVar(&s.Steps, "steps", "+1", "The comma separated changes of height a single step of a trail can take (e.g. \"+1,+2\")")
+}
+
+// Parse parses the puzzle input's topographic map, after checking the trails' step rule.
+func (s *Solver) Parse(r io.Reader) (grid.Grid[int], error) {
+	if _, err := s.stepRule(); err != nil {
+		return nil, err
+	}
+
+	g, err := grid.ReadInt(r, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load input grid: %v", err)
+	}
+	if s.Debug {
+		fmt.Printf("Grid:\n-----------------\n%s-----------------\n", g.String())
+	}
+	return g, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(g grid.Grid[int]) (solution.Answer, error) {
+	score, _, err := s.scoreTrailheads(g)
+	return score, err
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(g grid.Grid[int]) (solution.Answer, error) {
+	_, rating, err := s.scoreTrailheads(g)
+	return rating, err
+}
+
+// stepRule returns the step rule the solver is configured with.
+func (s *Solver) stepRule() (stepRule, error) {
+	deltas, err := slice.StringsToInts(strings.Split(s.Steps, ","))
+	if err != nil {
+		return stepRule{}, fmt.Errorf("invalid steps %q: %v", s.Steps, err)
+	}
+	rule := stepRule{directions: grid.Directions4, deltas: deltas}
+	if s.Diagonal {
+		rule.directions = grid.Directions8
+	}
+	if len(deltas) == 0 {
+		return stepRule{}, fmt.Errorf("the step rule has no changes of height")
+	}
+	return rule, nil
+}
+
+func (s *Solver) scoreTrailheads(g grid.Grid[int]) (int, int, error) {
+	rule, err := s.stepRule()
+	if err != nil {
+		return 0, 0, err
+	}
+	var trailheads []trailhead
+	if rule.monotonic(s.TrailStart, s.TrailEnd) {
+		trailheads = scoreTrails(g, s.TrailStart, s.TrailEnd, rule)
+	} else {
+		trailheads = searchTrails(g, s.TrailStart, s.TrailEnd, rule)
+	}
+
+	score, rating := 0, 0
+	for _, th := range trailheads {
+		if s.Debug {
+			log.Printf("Trailhead at (y=%d,x=%d) reaches %d summits by %d distinct trails", th.position.Y, th.position.X, th.score, th.rating)
+		}
+		score += th.score
+		rating += th.rating
+	}
+	return score, rating, nil
+}
+
+// stepRule says which steps a trail can take: to which neighbors, and by how much the height can change.
+type stepRule struct {
+	directions []grid.Direction
+	deltas     []int
+}
+
+// monotonic returns true when every step of the rule brings trails closer to the end's height,
+// such that they can be scored one height at a time (with scoreTrails), as they never go back
+// to heights they have left. Otherwise (e.g. for steps of ±1) they must be searched for.
+func (r stepRule) monotonic(start, end int) bool {
+	for _, d := range r.deltas {
+		if d == 0 || (end >= start && d < 0) || (end < start && d > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// allows returns true when the rule allows a step between the given heights.
+func (r stepRule) allows(from, to int) bool {
+	return slices.Contains(r.deltas, to-from)
+}
+
+// trailhead is where trails start, along with how many summits (where trails end)
+// they reach (its score) and how many distinct trails there are from it (its rating).
+type trailhead struct {
+	position grid.Coordinate
+	score    int
+	rating   int
+}
+
+// scoreTrails scores every trailhead on the map in a single pass over the heights, from the trail's end
+// back to its start (which may be higher or lower). As every step gets closer to the end's height, the
+// summits reachable from a cell, and the number of trails from it, follow from those of its neighbors.
+func scoreTrails(g grid.Grid[int], start, end int, rule stepRule) []trailhead {
+	toward := 1 // the direction of the change of height along trails
+	if end < start {
+		toward = -1
+	}
+
+	layers := make(map[int][]grid.Coordinate)
+	summits := 0
+	for c, v := range g.All() {
+		layers[v] = append(layers[v], c)
+		if v == end {
+			summits++
+		}
+	}
+
+	reachable := make(map[grid.Coordinate]bitset.Bitset)
+	paths := make(map[grid.Coordinate]int)
+	for c, v := range g.All() {
+		if (v-start)*toward >= 0 && (end-v)*toward >= 0 {
+			reachable[c] = bitset.New(summits)
+		}
+	}
+	for i, c := range layers[end] {
+		reachable[c].Put(i)
+		paths[c] = 1
+	}
+
+	for h := end - toward; (h-start)*toward >= 0; h -= toward {
+		for _, c := range layers[h] {
+			for _, d := range rule.directions {
+				n := c.Move(d)
+				nv, ok := g.Get(n)
+				if !ok || (nv-h)*toward <= 0 || (end-nv)*toward < 0 || !rule.allows(h, nv) {
+					continue
+				}
+				reachable[c].Union(reachable[n])
+				paths[c] += paths[n]
+			}
+		}
+	}
+
+	trailheads := []trailhead{}
+	for c, v := range g.All() {
+		if v == start {
+			trailheads = append(trailheads, trailhead{position: c, score: reachable[c].Size(), rating: paths[c]})
+		}
+	}
+	return trailheads
+}
+
+// searchTrails scores every trailhead on the map like scoreTrails, for step rules which are not monotonic,
+// under which trails could go back and forth forever. Trails are then the paths which never visit a cell
+// twice, ending at the first cell with the end's height. It searches every such path from each trailhead,
+// of which there can be exponentially many, so it is only feasible for small maps or restrictive rules.
+func searchTrails(g grid.Grid[int], start, end int, rule stepRule) []trailhead {
+	trailheads := []trailhead{}
+	for c, v := range g.All() {
+		if v != start {
+			continue
+		}
+		summits := set.New[grid.Coordinate]()
+		visited := bitset.New(g.Width() * g.Height())
+		rating := 0
+
+		var search func(c grid.Coordinate, v int)
+		search = func(c grid.Coordinate, v int) {
+			if v == end {
+				summits.Put(c)
+				rating++
+				return
+			}
+			i := c.Y*g.Width() + c.X
+			visited.Put(i)
+			for _, d := range rule.directions {
+				n := c.Move(d)
+				nv, ok := g.Get(n)
+				if ok && !visited.Has(n.Y*g.Width()+n.X) && rule.allows(v, nv) {
+					search(n, nv)
+				}
+			}
+			visited.Remove(i)
+		}
+		search(c, v)
+
+		trailheads = append(trailheads, trailhead{position: c, score: summits.Size(), rating: rating})
+	}
+	return trailheads
+}
@@ -0,0 +1,100 @@
+package day10
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestStepRules(t *testing.T) {
+	g := grid.Grid[int]{
+		{0, 1},
+		{1, 2},
+	}
+	tests := []struct {
+		name   string
+		rule   stepRule
+		rating int
+	}{
+		{"orthogonal", stepRule{directions: grid.Directions4, deltas: []int{1}}, 2},
+		{"orthogonal, steep", stepRule{directions: grid.Directions4, deltas: []int{1, 2}}, 2},
+		{"diagonal", stepRule{directions: grid.Directions8, deltas: []int{1}}, 2},
+		{"diagonal, steep", stepRule{directions: grid.Directions8, deltas: []int{1, 2}}, 3},
+		{"diagonal, only steep", stepRule{directions: grid.Directions8, deltas: []int{2}}, 1},
+	}
+	for _, test := range tests {
+		trailheads := scoreTrails(g, 0, 2, test.rule)
+		if len(trailheads) != 1 || trailheads[0].score != 1 || trailheads[0].rating != test.rating {
+			t.Errorf("%s: got %+v, want one trailhead with score 1 and rating %d", test.name, trailheads, test.rating)
+		}
+	}
+}
+
+func TestSolverStepRules(t *testing.T) {
+	const diagonalOnly = "09\n91\n"
+	tests := []struct {
+		name   string
+		solver *Solver
+		input  string
+		score  int
+		rating int
+		err    bool
+	}{
+		{"+1 only", &Solver{TrailStart: 0, TrailEnd: 9, Steps: "+1"}, "0123\n1234\n8765\n9876\n", 1, 16, false},
+		{"±1", &Solver{TrailStart: 0, TrailEnd: 9, Steps: "-1,+1"}, "0123\n1234\n8765\n9876\n", 1, 64, false},
+		{"+1 only, crossing", &Solver{TrailStart: 0, TrailEnd: 2, Steps: "+1"}, "012\n101\n210\n", 6, 8, false},
+		{"±1, crossing", &Solver{TrailStart: 0, TrailEnd: 2, Steps: "-1,+1"}, "012\n101\n210\n", 6, 32, false},
+		{"diagonals", &Solver{TrailStart: 0, TrailEnd: 1, Steps: "+1", Diagonal: true}, diagonalOnly, 1, 1, false},
+		{"orthogonal only", &Solver{TrailStart: 0, TrailEnd: 1, Steps: "+1"}, diagonalOnly, 0, 0, false},
+		{"invalid steps", &Solver{TrailStart: 0, TrailEnd: 9, Steps: "+1,up"}, diagonalOnly, 0, 0, true},
+	}
+	for _, test := range tests {
+		g, err := test.solver.Parse(strings.NewReader(test.input))
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		score, rating, err := test.solver.scoreTrailheads(g)
+		if err != nil || score != test.score || rating != test.rating {
+			t.Errorf("%s: got score %d and rating %d (%v), want %d and %d", test.name, score, rating, err, test.score, test.rating)
+		}
+	}
+}
+
+func TestDescendingTrails(t *testing.T) {
+	f, err := os.Open("sample-input-36.txt")
+	if err != nil {
+		t.Fatalf("failed to open sample input: %v", err)
+	}
+	defer f.Close()
+	g, err := grid.ReadInt(f, "")
+	if err != nil {
+		t.Fatalf("failed to read sample input: %v", err)
+	}
+
+	// every trail up is a trail down, so the ratings add up to the same
+	rating := 0
+	for _, th := range scoreTrails(g, 9, 0, stepRule{directions: grid.Directions4, deltas: []int{-1}}) {
+		rating += th.rating
+	}
+	if rating != 81 {
+		t.Errorf("got a total rating of %d going down, want 81", rating)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
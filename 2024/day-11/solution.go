@@ -0,0 +1,226 @@
+package day11
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adrianosela/adventofcode/utils/slice"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	part1Blinks = 25
+	part2Blinks = 75
+)
+
+// Solver solves the puzzle for 2024 day 11.
+type Solver struct {
+	LogState     bool
+	LogDurations bool
+	Blinks       int
+	Big          bool
+}
+
+// New returns the solver for 2024 day 11.
+func New() solution.Puzzle {
+	return solution.Erase[[]int](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.LogState, "log-state", false, "Whether to log how many stones of each number there are after each blink")
+	fs.BoolVar(&s.LogDurations, "log-durations", false, "Whether to log the blinking computation's durations")
+	fs.IntVar(&s.Blinks, "blinks", 0, "How many times to blink, instead of the puzzle's (25 in part 1 and 75 in part 2)")
+	fs.BoolVar(&s.Big, "big", false, "Whether to count stones with arbitrary precision, for blinks too many to count in an int")
+}
+
+// Parse parses the puzzle input's arrangement of stones.
+func (s *Solver) Parse(r io.Reader) ([]int, error) {
+	byt, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %v", err)
+	}
+	ints, err := slice.StringsToInts(strings.Fields(string(byt)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert string to integer slice: %v", err)
+	}
+	return ints, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(stones []int) (solution.Answer, error) {
+	return s.solve(stones, part1Blinks), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(stones []int) (solution.Answer, error) {
+	return s.solve(stones, part2Blinks), nil
+}
+
+func (s *Solver) solve(stones []int, blinks int) solution.Answer {
+	if s.Blinks > 0 {
+		blinks = s.Blinks
+	}
+	if s.Big {
+		return run(newEngine(bigCount, defaultRules), stones, blinks, s)
+	}
+	return run(newEngine(intCount, defaultRules), stones, blinks, s)
+}
+
+// run blinks the given number of times, logging what the solver is configured to, and returns the number of stones.
+func run[C any](e *engine[C], stones []int, blinks int, s *Solver) C {
+	start := time.Now()
+	return e.evolve(stones, blinks, func(blink int, h histogram[C]) {
+		if s.LogState {
+			log.Printf("After %d blinks: %d distinct stones, %v in total: %s", blink, h.distinct(), e.total(h), h)
+		}
+		if s.LogDurations && blink > 0 {
+			log.Printf("Blink %d took %s to compute", blink, time.Since(start))
+			start = time.Now()
+		}
+	})
+}
+
+// rule changes a stone when it blinks, returning the stones it becomes and true, or false when it does not apply to the stone.
+type rule func(stone int) ([]int, bool)
+
+// defaultRules are the puzzle's rules, of which the first which applies to a stone changes it.
+var defaultRules = []rule{zeroToOne, splitEvenDigits, multiplyBy2024}
+
+// zeroToOne replaces a stone engraved with 0 by a stone engraved with 1.
+func zeroToOne(stone int) ([]int, bool) {
+	if stone != 0 {
+		return nil, false
+	}
+	return []int{1}, true
+}
+
+// splitEvenDigits splits a stone with an even number of digits into two stones,
+// with the left and right halves of the digits (without leading zeroes).
+func splitEvenDigits(stone int) ([]int, bool) {
+	str := strconv.Itoa(stone)
+	if len(str)%2 != 0 {
+		return nil, false
+	}
+	left, _ := strconv.Atoi(str[:len(str)/2])
+	right, _ := strconv.Atoi(str[len(str)/2:])
+	return []int{left, right}, true
+}
+
+// multiplyBy2024 replaces a stone by one engraved with its number multiplied by 2024.
+func multiplyBy2024(stone int) ([]int, bool) {
+	return []int{stone * 2024}, true
+}
+
+// histogram is how many stones there are with each number. The order of the stones never
+// matters to how they change, so the stones are counted instead of kept in order.
+type histogram[C any] map[int]C
+
+// distinct returns the number of different numbers on the stones.
+func (h histogram[C]) distinct() int {
+	return len(h)
+}
+
+// add counts n more stones with the given number.
+func (h histogram[C]) add(stone int, n C, count counter[C]) {
+	if m, ok := h[stone]; ok {
+		n = count.add(m, n)
+	}
+	h[stone] = n
+}
+
+// String returns the counts of the stones in order of their numbers, e.g. "0×2 1×5 2024×1".
+func (h histogram[C]) String() string {
+	parts := make([]string, 0, len(h))
+	for _, stone := range slices.Sorted(maps.Keys(h)) {
+		parts = append(parts, fmt.Sprintf("%d×%v", stone, h[stone]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// counter is how the engine counts stones: a plain int, or a *big.Int for blinks too many for an int.
+type counter[C any] struct {
+	zero, one C
+	add       func(a, b C) C
+}
+
+var (
+	intCount = counter[int]{zero: 0, one: 1, add: func(a, b int) int { return a + b }}
+	bigCount = counter[*big.Int]{zero: big.NewInt(0), one: big.NewInt(1), add: func(a, b *big.Int) *big.Int { return new(big.Int).Add(a, b) }}
+)
+
+// engine changes the stones when blinking, keeping count of them with C.
+type engine[C any] struct {
+	count counter[C]
+	rules []rule
+	// next caches what each number's stone becomes, as stones with the same numbers come up over and over
+	next map[int][]int
+}
+
+func newEngine[C any](count counter[C], rules []rule) *engine[C] {
+	return &engine[C]{count: count, rules: rules, next: make(map[int][]int)}
+}
+
+// change returns what the stone becomes when blinking, by the first of the
+// rules which applies to it, or the stone itself when none does.
+func (e *engine[C]) change(stone int) []int {
+	if next, ok := e.next[stone]; ok {
+		return next
+	}
+	next := []int{stone}
+	for _, r := range e.rules {
+		if stones, ok := r(stone); ok {
+			next = stones
+			break
+		}
+	}
+	e.next[stone] = next
+	return next
+}
+
+// blink returns the stones after blinking once.
+func (e *engine[C]) blink(h histogram[C]) histogram[C] {
+	next := make(histogram[C], len(h))
+	for stone, n := range h {
+		for _, s := range e.change(stone) {
+			next.add(s, n, e.count)
+		}
+	}
+	return next
+}
+
+// total returns the number of stones.
+func (e *engine[C]) total(h histogram[C]) C {
+	total := e.count.zero
+	for _, n := range h {
+		total = e.count.add(total, n)
+	}
+	return total
+}
+
+// evolve blinks the given number of times at the stones, calling observe (unless nil) with
+// the stones before blinking and after every blink, and returns how many stones there are.
+func (e *engine[C]) evolve(stones []int, blinks int, observe func(blink int, h histogram[C])) C {
+	h := make(histogram[C])
+	for _, s := range stones {
+		h.add(s, e.count.one, e.count)
+	}
+	if observe != nil {
+		observe(0, h)
+	}
+	for blink := 1; blink <= blinks; blink++ {
+		h = e.blink(h)
+		if observe != nil {
+			observe(blink, h)
+		}
+	}
+	return e.total(h)
+}
@@ -0,0 +1,52 @@
+package day11
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestEvolve(t *testing.T) {
+	// the number of stones after each blink of the puzzle's example
+	want := []int{2, 3, 4, 5, 9, 13, 22}
+	got := []int{}
+	e := newEngine(intCount, defaultRules)
+	e.evolve([]int{125, 17}, 6, func(_ int, h histogram[int]) {
+		got = append(got, e.total(h))
+	})
+	if !slices.Equal(got, want) {
+		t.Errorf("got totals %v, want %v", got, want)
+	}
+
+	n := newEngine(intCount, defaultRules).evolve([]int{125, 17}, 75, nil)
+	b := newEngine(bigCount, defaultRules).evolve([]int{125, 17}, 75, nil)
+	if b.Cmp(big.NewInt(int64(n))) != 0 {
+		t.Errorf("counting with big ints gives %v, but %d with ints", b, n)
+	}
+}
+
+func TestCustomRules(t *testing.T) {
+	// every stone splits in two, and stones no rule applies to stay as they are
+	double := func(stone int) ([]int, bool) {
+		if stone%2 == 0 {
+			return nil, false
+		}
+		return []int{stone, stone}, true
+	}
+	h := histogram[int]{}
+	e := newEngine(intCount, []rule{double})
+	total := e.evolve([]int{1, 2, 1}, 3, func(_ int, s histogram[int]) { h = s })
+	if total != 17 || h.distinct() != 2 || h[1] != 16 || h[2] != 1 {
+		t.Errorf("got %d stones (%s), want 16 ones and a two", total, h)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -0,0 +1,47 @@
+package day12
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Solver solves the puzzle for 2024 day 12.
+type Solver struct{}
+
+// New returns the solver for 2024 day 12.
+func New() solution.Puzzle {
+	return solution.Erase[grid.Grid[byte]](Solver{})
+}
+
+// Parse parses the puzzle input's map of garden plots.
+func (Solver) Parse(r io.Reader) (grid.Grid[byte], error) {
+	g, err := grid.ReadByte(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load byte grid from input: %v", err)
+	}
+	return g, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(g grid.Grid[byte]) (solution.Answer, error) {
+	return price(g, (*grid.Region[byte]).Perimeter), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(g grid.Grid[byte]) (solution.Answer, error) {
+	// with the bulk discount, fences are priced by their number of straight sides
+	return price(g, (*grid.Region[byte]).Sides), nil
+}
+
+// price returns the total price of fencing every region of the garden, where the
+// price of a region is its area times the given measure of its fence.
+func price(g grid.Grid[byte], fence func(*grid.Region[byte]) int) int {
+	sum := 0
+	for _, region := range grid.Regions(g) {
+		sum += region.Area() * fence(region)
+	}
+	return sum
+}
@@ -0,0 +1,229 @@
+package day13
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/linalg"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	prefixButtonA = "Button A: "
+	prefixButtonB = "Button B: "
+	prefixPrize   = "Prize: "
+)
+
+// Solver solves the puzzle for 2024 day 13.
+type Solver struct {
+	Debug bool
+}
+
+// New returns the solver for 2024 day 13.
+func New() solution.Puzzle {
+	return solution.Erase[*input](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+}
+
+// Parse parses the puzzle input's claw machines.
+func (s *Solver) Parse(r io.Reader) (*input, error) {
+	in, err := loadInput(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load input: %v", err)
+	}
+	return in, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(in *input) (solution.Answer, error) {
+	return in.solvePart1(s.Debug)
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(in *input) (solution.Answer, error) {
+	return in.solvePart2(s.Debug)
+}
+
+type input struct {
+	machines []machine
+}
+
+type machine struct {
+	ax     int
+	ay     int
+	bx     int
+	by     int
+	prizex int
+	prizey int
+}
+
+const (
+	tokensA = 3
+	tokensB = 1
+	// maxPresses is the most times each button can be pressed in part 1
+	maxPresses = 100
+	// prizeOffset is added to both coordinates of every prize in part 2
+	prizeOffset = 10000000000000
+)
+
+type result struct {
+	tokens   int
+	solvable bool
+}
+
+func (in *input) solvePart1(debug bool) (int, error) {
+	return in.sumTokens(0, maxPresses, debug)
+}
+
+func (in *input) solvePart2(debug bool) (int, error) {
+	return in.sumTokens(prizeOffset, linalg.Unbounded, debug)
+}
+
+func (in *input) sumTokens(offset int, limit int, debug bool) (int, error) {
+	sum := 0
+	for i := 0; i < len(in.machines); i++ {
+		machine := in.machines[i]
+		machine.prizex += offset
+		machine.prizey += offset
+
+		result, err := machine.solve(limit)
+		if err != nil {
+			return 0, fmt.Errorf("failed to solve machine %d: %v", i+1, err)
+		}
+		if result.solvable {
+			if debug {
+				fmt.Printf("Machine %d is solvable with %d tokens\n", i+1, result.tokens)
+			}
+			sum += result.tokens
+			continue
+		}
+		if debug {
+			fmt.Printf("Machine %d is NOT solvable\n", i+1)
+		}
+	}
+	return sum, nil
+}
+
+// solve finds the cheapest presses of A and B, each at most limit times (unless
+// unbounded), which move the claw onto the prize, as a system of 2 equations:
+// ⚫ Equation 1: (ax * P) + (bx * Q) = prizex
+// ⚫ Equation 2: (ay * P) + (by * Q) = prizey
+//
+// Usually it has a single solution, which only counts when P and Q are non-negative
+// integers. But when the buttons move the claw in the same direction (or not at all)
+// it can have infinitely many, of which the one costing the fewest tokens is chosen.
+func (m *machine) solve(limit int) (result, error) {
+	presses, ok, err := linalg.MinimizeNonNegative(
+		[][]int{{m.ax, m.bx}, {m.ay, m.by}},
+		[]int{m.prizex, m.prizey},
+		[]int{tokensA, tokensB},
+		limit,
+	)
+	if err != nil {
+		return result{}, err
+	}
+	if !ok {
+		return result{solvable: false}, nil
+	}
+	return result{tokens: tokensA*presses[0] + tokensB*presses[1], solvable: true}, nil
+}
+
+func loadInput(r io.Reader) (*input, error) {
+	scanner := bufio.NewScanner(r)
+
+	machines := []machine{}
+	currentMachine := machine{}
+
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if line == "" {
+			machines = append(machines, currentMachine)
+			currentMachine = machine{}
+			continue
+		}
+
+		if strings.HasPrefix(line, prefixButtonA) {
+			buttonAParts := strings.Split(strings.TrimPrefix(line, prefixButtonA), ", ")
+			if len(buttonAParts) != 2 {
+				return nil, fmt.Errorf("line %d does not have 2 parts (\"%s\")", lineNo, line)
+			}
+
+			axStr := strings.TrimPrefix(buttonAParts[0], "X+")
+			ax, err := strconv.Atoi(axStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert X offset to integer: %v", err)
+			}
+
+			ayStr := strings.TrimPrefix(buttonAParts[1], "Y+")
+			ay, err := strconv.Atoi(ayStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert Y offset to integer: %v", err)
+			}
+
+			currentMachine.ax = ax
+			currentMachine.ay = ay
+			continue
+		}
+
+		if strings.HasPrefix(line, prefixButtonB) {
+			buttonBParts := strings.Split(strings.TrimPrefix(line, prefixButtonB), ", ")
+			if len(buttonBParts) != 2 {
+				return nil, fmt.Errorf("line %d does not have 2 parts (\"%s\")", lineNo, line)
+			}
+
+			bxStr := strings.TrimPrefix(buttonBParts[0], "X+")
+			bx, err := strconv.Atoi(bxStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert X offset to integer: %v", err)
+			}
+
+			byStr := strings.TrimPrefix(buttonBParts[1], "Y+")
+			by, err := strconv.Atoi(byStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert Y offset to integer: %v", err)
+			}
+
+			currentMachine.bx = bx
+			currentMachine.by = by
+			continue
+		}
+
+		if strings.HasPrefix(line, prefixPrize) {
+			prizeParts := strings.Split(strings.TrimPrefix(line, prefixPrize), ", ")
+			if len(prizeParts) != 2 {
+				return nil, fmt.Errorf("line %d does not have 2 parts (\"%s\")", lineNo, line)
+			}
+
+			prizeXStr := strings.TrimPrefix(prizeParts[0], "X=")
+			prizex, err := strconv.Atoi(prizeXStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert X offset to integer: %v", err)
+			}
+
+			prizeyStr := strings.TrimPrefix(prizeParts[1], "Y=")
+			prizey, err := strconv.Atoi(prizeyStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert Y offset to integer: %v", err)
+			}
+
+			currentMachine.prizex = prizex
+			currentMachine.prizey = prizey
+			continue
+		}
+	}
+	machines = append(machines, currentMachine)
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan over file: %v", err)
+	}
+
+	return &input{machines: machines}, nil
+}
@@ -0,0 +1,39 @@
+package day13
+
+import (
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/linalg"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+func TestDegenerateMachines(t *testing.T) {
+	tests := []struct {
+		name string
+		m    machine
+		want result
+	}{
+		{"collinear, B is cheaper", machine{ax: 2, ay: 3, bx: 4, by: 6, prizex: 20, prizey: 30}, result{tokens: 5, solvable: true}},
+		{"collinear, A is cheaper per step", machine{ax: 6, ay: 6, bx: 1, by: 1, prizex: 13, prizey: 13}, result{tokens: 7, solvable: true}},
+		{"collinear, unreachable", machine{ax: 2, ay: 2, bx: 4, by: 4, prizex: 7, prizey: 7}, result{solvable: false}},
+		{"A does not move X", machine{ax: 0, ay: 3, bx: 5, by: 4, prizex: 25, prizey: 29}, result{tokens: 14, solvable: true}},
+	}
+	for _, test := range tests {
+		got, err := test.m.solve(linalg.Unbounded)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -0,0 +1,339 @@
+package day14
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"context"
+	"flag"
+	"fmt"
+	"image/color"
+	"io"
+	"log"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/numtheory"
+	"github.com/adrianosela/adventofcode/utils/render"
+	"github.com/adrianosela/adventofcode/utils/set"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+type robot struct {
+	position grid.Coordinate
+	velocity grid.Coordinate
+}
+
+// Solver solves the puzzle for 2024 day 14.
+type Solver struct {
+	Width   int
+	Height  int
+	Metric  string
+	Timeout time.Duration
+	PNG     string
+	Debug   bool
+}
+
+// New returns the solver for 2024 day 14.
+func New() solution.Puzzle {
+	return solution.Erase[[]robot](&Solver{Width: 101, Height: 103, Metric: "safety"})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.Width, "width", 101, "The width of the space the robots move in (11 for the sample)")
+	fs.IntVar(&s.Height, "height", 103, "The height of the space the robots move in (7 for the sample)")
+	fs.StringVar(&s.Metric, "metric", "safety", "How to score each second in part 2, one of "+strings.Join(slices.Sorted(maps.Keys(metrics)), ", "))
+	fs.DurationVar(&s.Timeout, "timeout", 0, "How long to search for the picture in part 2 before giving up (0 for no limit)")
+	fs.StringVar(&s.PNG, "png", "", "A file to draw the robots in, as they are when they form the picture in part 2")
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+}
+
+// Parse parses the puzzle input's robots.
+func (s *Solver) Parse(r io.Reader) ([]robot, error) {
+	robots, err := loadInput(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load robots data: %v", err)
+	}
+	return robots, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(robots []robot) (solution.Answer, error) {
+	return part1(robots, grid.Coordinate{X: s.Width, Y: s.Height}, 100), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(robots []robot) (solution.Answer, error) {
+	score, ok := metrics[s.Metric]
+	if !ok {
+		return nil, fmt.Errorf("unknown metric \"%s\", must be one of %s", s.Metric, strings.Join(slices.Sorted(maps.Keys(metrics)), ", "))
+	}
+
+	ctx := context.Background()
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+
+	gridDims := grid.Coordinate{X: s.Width, Y: s.Height}
+	egg, err := findEasterEgg(ctx, robots, gridDims, score)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the easter egg: %v", err)
+	}
+	// the answer is just the seconds, so the score (how sure the metric is about them) is logged along with it
+	log.Printf("Lowest %s score is %g, after %d seconds", s.Metric, egg.score, egg.seconds)
+	positions := positionsAt(robots, gridDims, egg.seconds)
+	if s.Debug {
+		fmt.Print(render.Text(render.FromCoordinates(positions, gridDims.X, gridDims.Y, '#', '.')))
+	}
+	if s.PNG != "" {
+		if err := drawRobots(s.PNG, positions, gridDims); err != nil {
+			return nil, fmt.Errorf("failed to draw the easter egg: %v", err)
+		}
+	}
+	return egg.seconds, nil
+}
+
+// drawRobots draws the robots at the given positions as a PNG image in the given file.
+func drawRobots(filename string, positions []grid.Coordinate, gridDims grid.Coordinate) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create image file: %v", err)
+	}
+	defer f.Close()
+
+	g := render.FromCoordinates(positions, gridDims.X, gridDims.Y, '#', '.')
+	style := render.Style{Colors: map[byte]color.Color{'#': color.RGBA{G: 200, A: 255}}, Default: color.Black}
+	if err := render.PNG(f, g, style, 4); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func loadInput(r io.Reader) ([]robot, error) {
+	robots := []robot{}
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		// space separated position and velocity
+		positionPart, velocityPart, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid input on line %d (no space)", lineNo)
+		}
+
+		_, positionStr, ok := strings.Cut(positionPart, "p=")
+		if !ok {
+			return nil, fmt.Errorf("invalid input on line %d (position part does not start with p=)", lineNo)
+		}
+		position, err := parseCoord(positionStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input on line %d (invalid position coordinate): %v", lineNo, err)
+		}
+
+		_, velocityStr, ok := strings.Cut(velocityPart, "v=")
+		if !ok {
+			return nil, fmt.Errorf("invalid input on line %d (velocity part does not start with v=)", lineNo)
+		}
+		velocity, err := parseCoord(velocityStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input on line %d (invalid velocity coordinate): %v", lineNo, err)
+		}
+
+		robots = append(robots, robot{
+			position: position,
+			velocity: velocity,
+		})
+	}
+
+	return robots, nil
+}
+
+// parses comma separated coordinates of the form "X,Y" e.g. "11,7"
+func parseCoord(coords string) (grid.Coordinate, error) {
+	xStr, yStr, ok := strings.Cut(coords, ",")
+	if !ok {
+		return grid.Coordinate{}, fmt.Errorf("coordinates not comma separated: \"%s\"", coords)
+	}
+	x, err := strconv.Atoi(xStr)
+	if err != nil {
+		return grid.Coordinate{}, fmt.Errorf("x coordinate not an integer: \"%s\"", xStr)
+	}
+	y, err := strconv.Atoi(yStr)
+	if err != nil {
+		return grid.Coordinate{}, fmt.Errorf("y coordinate not an integer: \"%s\"", xStr)
+	}
+	return grid.Coordinate{X: x, Y: y}, nil
+}
+
+func part1(robots []robot, gridDims grid.Coordinate, seconds int) int {
+	return safetyFactor(positionsAt(robots, gridDims, seconds), gridDims)
+}
+
+// positionsAt returns the positions of the robots after the given number of seconds.
+func positionsAt(robots []robot, gridDims grid.Coordinate, seconds int) []grid.Coordinate {
+	positions := make([]grid.Coordinate, len(robots))
+	for i, robot := range robots {
+		// positions wrap around the edges of the space
+		positions[i] = robot.position.Add(robot.velocity.Scale(seconds)).Mod(gridDims)
+	}
+	return positions
+}
+
+// safetyFactor returns the product of the numbers of robots in each quadrant of the space.
+func safetyFactor(positions []grid.Coordinate, gridDims grid.Coordinate) int {
+	quadNW := 0
+	quadNE := 0
+	quadSW := 0
+	quadSE := 0
+
+	for _, after := range positions {
+		afterX, afterY := after.X, after.Y
+
+		// is in northwest quadrant
+		if afterX < gridDims.X/2 && afterY < gridDims.Y/2 {
+			quadNW++
+			continue
+		}
+		// is in northeast quadrant
+		if afterX > gridDims.X/2 && afterY < gridDims.Y/2 {
+			quadNE++
+			continue
+		}
+		// is in southwest quadrant
+		if afterX < gridDims.X/2 && afterY > gridDims.Y/2 {
+			quadSW++
+			continue
+		}
+		// is in southeast quadrant
+		if afterX > gridDims.X/2 && afterY > gridDims.Y/2 {
+			quadSE++
+			continue
+		}
+	}
+
+	return quadNW * quadNE * quadSW * quadSE
+}
+
+// period returns the number of seconds after which every robot is back where it
+// started. Each robot's X repeats once it has moved a multiple of the width, i.e.
+// every width / gcd(vx, width) seconds (and likewise for Y), so the whole space
+// repeats after the lcm of those (at most lcm(width, height), e.g. 101*103).
+func period(robots []robot, gridDims grid.Coordinate) int {
+	periods := []int{1}
+	for _, robot := range robots {
+		periods = append(periods,
+			gridDims.X/numtheory.GCD(robot.velocity.X, gridDims.X),
+			gridDims.Y/numtheory.GCD(robot.velocity.Y, gridDims.Y),
+		)
+	}
+	return numtheory.LCM(periods...)
+}
+
+// metric scores the positions of the robots at some second, where the
+// lower the score, the more likely it is that they form the picture.
+type metric func(positions []grid.Coordinate, gridDims grid.Coordinate) float64
+
+var metrics = map[string]metric{
+	// robots bunched together leave some quadrants nearly empty
+	"safety": func(positions []grid.Coordinate, gridDims grid.Coordinate) float64 {
+		return float64(safetyFactor(positions, gridDims))
+	},
+	// robots bunched together are close to their mean position on both axes
+	"variance": func(positions []grid.Coordinate, _ grid.Coordinate) float64 {
+		xs, ys := make([]int, len(positions)), make([]int, len(positions))
+		for i, p := range positions {
+			xs[i], ys[i] = p.X, p.Y
+		}
+		return variance(xs) + variance(ys)
+	},
+	// the picture is drawn with robots next to each other
+	"cluster": func(positions []grid.Coordinate, _ grid.Coordinate) float64 {
+		return -float64(largestCluster(positions))
+	},
+	// the picture is a regular pattern, which compresses better than noise
+	"entropy": func(positions []grid.Coordinate, gridDims grid.Coordinate) float64 {
+		return float64(compressedSize(positions, gridDims))
+	},
+}
+
+func variance(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum, sumSquares := 0.0, 0.0
+	for _, v := range values {
+		sum += float64(v)
+		sumSquares += float64(v) * float64(v)
+	}
+	mean := sum / float64(len(values))
+	return sumSquares/float64(len(values)) - mean*mean
+}
+
+// largestCluster returns the number of robots in the largest group of
+// positions which are connected through their orthogonal neighbors.
+func largestCluster(positions []grid.Coordinate) int {
+	unvisited := set.New(positions...)
+	largest := 0
+	for _, start := range positions {
+		if !unvisited.Has(start) {
+			continue
+		}
+		unvisited.Remove(start)
+		cluster := []grid.Coordinate{start}
+		for i := 0; i < len(cluster); i++ {
+			for _, d := range grid.Directions4 {
+				if next := cluster[i].Move(d); unvisited.Has(next) {
+					unvisited.Remove(next)
+					cluster = append(cluster, next)
+				}
+			}
+		}
+		largest = max(largest, len(cluster))
+	}
+	return largest
+}
+
+// compressedSize returns the size of the space, drawn with a byte per
+// position, once compressed with DEFLATE.
+func compressedSize(positions []grid.Coordinate, gridDims grid.Coordinate) int {
+	drawn := bytes.Repeat([]byte{'.'}, gridDims.X*gridDims.Y)
+	for _, p := range positions {
+		drawn[p.Y*gridDims.X+p.X] = '#'
+	}
+
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.BestSpeed) // only fails for invalid levels
+	w.Write(drawn)
+	w.Close()
+	return buf.Len()
+}
+
+type easterEgg struct {
+	seconds int
+	score   float64
+}
+
+// findEasterEgg scores every second of the robots' period with the given metric,
+// returning the one with the lowest score (the earliest of any ties). Every
+// configuration of the robots occurs within one period, so the search is exhaustive
+// and its result reproducible. It stops early when the context is done.
+func findEasterEgg(ctx context.Context, robots []robot, gridDims grid.Coordinate, score metric) (easterEgg, error) {
+	best := easterEgg{seconds: -1, score: math.Inf(1)}
+	for seconds, end := 0, period(robots, gridDims); seconds < end; seconds++ {
+		if err := ctx.Err(); err != nil {
+			return easterEgg{}, fmt.Errorf("stopped after %d of %d seconds: %w", seconds, end, err)
+		}
+		if s := score(positionsAt(robots, gridDims, seconds), gridDims); s < best.score {
+			best = easterEgg{seconds: seconds, score: s}
+		}
+	}
+	return best, nil
+}
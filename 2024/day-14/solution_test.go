@@ -0,0 +1,86 @@
+package day14
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/numtheory"
+	"github.com/adrianosela/adventofcode/utils/solution"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+// TestSample checks the sample, whose robots move in a smaller space than the real input's.
+func TestSample(t *testing.T) {
+	p := solution.Erase[[]robot](&Solver{Width: 11, Height: 7})
+	solutiontest.Check(t, p, "sample-input-12.txt", 1, "12")
+}
+
+// TestPeriod checks that the robots repeat after the computed period, and not before.
+func TestPeriod(t *testing.T) {
+	f, err := os.Open("sample-input-12.txt")
+	if err != nil {
+		t.Fatalf("failed to open sample: %v", err)
+	}
+	defer f.Close()
+	robots, err := loadInput(f)
+	if err != nil {
+		t.Fatalf("failed to load input: %v", err)
+	}
+
+	gridDims := grid.Coordinate{X: 11, Y: 7}
+	positions := func(seconds int) string {
+		var sb strings.Builder
+		for _, robot := range robots {
+			fmt.Fprint(&sb, robot.position.Add(robot.velocity.Scale(seconds)).Mod(gridDims))
+		}
+		return sb.String()
+	}
+	_, found := numtheory.Brent(0, func(seconds int) int { return seconds + 1 }, positions)
+	if want := period(robots, gridDims); found != want {
+		t.Errorf("found a period of %d seconds, want %d", found, want)
+	}
+}
+
+// TestMetrics checks that every metric finds the picture in the input, and that the search can be cancelled.
+func TestMetrics(t *testing.T) {
+	f, err := os.Open("input.txt")
+	if err != nil {
+		t.Skipf("no input: %v", err)
+	}
+	defer f.Close()
+	robots, err := loadInput(f)
+	if err != nil {
+		t.Fatalf("failed to load input: %v", err)
+	}
+
+	gridDims := grid.Coordinate{X: 101, Y: 103}
+	for name, score := range metrics {
+		egg, err := findEasterEgg(context.Background(), robots, gridDims, score)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if egg.seconds != 6512 {
+			t.Errorf("%s: found the picture after %d seconds (score %g), want 6512", name, egg.seconds, egg.score)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := findEasterEgg(ctx, robots, gridDims, metrics["safety"]); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v from a cancelled search, want %v", err, context.Canceled)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
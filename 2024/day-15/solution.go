@@ -0,0 +1,281 @@
+package day15
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"time"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/render"
+	"github.com/adrianosela/adventofcode/utils/set"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	indicatorRobot    = '@'
+	indicatorWall     = '#'
+	indicatorEmpty    = '.'
+	indicatorBox      = 'O'
+	indicatorBoxLeft  = '['
+	indicatorBoxRight = ']'
+)
+
+// Solver solves the puzzle for 2024 day 15.
+type Solver struct {
+	Animate time.Duration
+	Replay  string
+}
+
+// New returns the solver for 2024 day 15.
+func New() solution.Puzzle {
+	return solution.Erase[*input](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.DurationVar(&s.Animate, "animate", 0, "How long to show the warehouse in the terminal after every move (0 to not show it)")
+	fs.StringVar(&s.Replay, "replay", "", "A file to write the warehouse to after every move, or \"-\" for stdout")
+}
+
+// style colors the warehouse when it is animated.
+var style = render.Style{
+	Colors: map[byte]color.Color{
+		indicatorRobot:    color.RGBA{R: 255, G: 80, A: 255},
+		indicatorWall:     color.RGBA{R: 120, G: 120, B: 120, A: 255},
+		indicatorEmpty:    color.RGBA{R: 60, G: 60, B: 60, A: 255},
+		indicatorBox:      color.RGBA{R: 230, G: 190, B: 80, A: 255},
+		indicatorBoxLeft:  color.RGBA{R: 230, G: 190, B: 80, A: 255},
+		indicatorBoxRight: color.RGBA{R: 230, G: 190, B: 80, A: 255},
+	},
+}
+
+// observer is called with the warehouse before the first move (step 0) and after every move.
+type observer func(step int, g grid.Grid[byte]) error
+
+// observe returns the observer which animates and replays the moves as configured (nil
+// for neither), and a function to close the files it writes to once the moves are done.
+func (s *Solver) observe(moves []grid.Direction) (observer, func() error, error) {
+	observers := []observer{}
+	closers := []func() error{}
+
+	if s.Animate > 0 {
+		terminal := &render.Terminal{Out: os.Stdout, Style: style, Delay: s.Animate}
+		observers = append(observers, func(_ int, g grid.Grid[byte]) error {
+			return terminal.Frame(g)
+		})
+	}
+	if s.Replay != "" {
+		w := io.Writer(os.Stdout)
+		if s.Replay != "-" {
+			f, err := os.Create(s.Replay)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create replay file: %v", err)
+			}
+			w = f
+			closers = append(closers, f.Close)
+		}
+		observers = append(observers, replay(w, moves))
+	}
+
+	closeAll := func() error {
+		for _, close := range closers {
+			if err := close(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(observers) == 0 {
+		return nil, closeAll, nil
+	}
+	return func(step int, g grid.Grid[byte]) error {
+		for _, o := range observers {
+			if err := o(step, g); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, closeAll, nil
+}
+
+// replay returns an observer which writes every intermediate
+// warehouse to w, in the format of the puzzle's examples.
+func replay(w io.Writer, moves []grid.Direction) observer {
+	return func(step int, g grid.Grid[byte]) error {
+		header := "Initial state:\n"
+		if step > 0 {
+			header = fmt.Sprintf("\nMove %c:\n", moves[step-1].Arrow())
+		}
+		if _, err := io.WriteString(w, header+render.Text(g)); err != nil {
+			return fmt.Errorf("failed to write replay: %v", err)
+		}
+		return nil
+	}
+}
+
+type input struct {
+	warehouse grid.Grid[byte]
+	moves     []grid.Direction
+}
+
+// Parse parses the puzzle input's warehouse map and robot moves.
+func (s *Solver) Parse(r io.Reader) (*input, error) {
+	g := grid.New[byte]()
+	moves := []grid.Direction{}
+
+	gridDone := false
+	scanner := bufio.NewScanner(r)
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if len(line) == 0 {
+			gridDone = true
+			continue
+		}
+		if !gridDone {
+			g = append(g, []byte(line))
+			continue
+		}
+		for _, char := range []byte(line) {
+			move, err := grid.ParseDirection(char)
+			if err != nil {
+				return nil, fmt.Errorf("invalid move on line %d: %v", lineNo, err)
+			}
+			moves = append(moves, move)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan file contents: %v", err)
+	}
+
+	return &input{warehouse: g, moves: moves}, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(in *input) (solution.Answer, error) {
+	return s.solve(in.warehouse.Clone(), in.moves)
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(in *input) (solution.Answer, error) {
+	return s.solve(widen(in.warehouse), in.moves)
+}
+
+func (s *Solver) solve(g grid.Grid[byte], moves []grid.Direction) (int, error) {
+	observe, closeAll, err := s.observe(moves)
+	if err != nil {
+		return 0, err
+	}
+	sum, err := simulate(g, moves, observe)
+	if closeErr := closeAll(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close replay file: %v", closeErr)
+	}
+	return sum, err
+}
+
+// widen returns the warehouse with everything but the robot twice as wide.
+func widen(g grid.Grid[byte]) grid.Grid[byte] {
+	wide := make(grid.Grid[byte], len(g))
+	for y, row := range g {
+		wide[y] = make([]byte, 0, 2*len(row))
+		for _, v := range row {
+			switch v {
+			case indicatorBox:
+				wide[y] = append(wide[y], indicatorBoxLeft, indicatorBoxRight)
+			case indicatorRobot:
+				wide[y] = append(wide[y], indicatorRobot, indicatorEmpty)
+			default:
+				wide[y] = append(wide[y], v, v)
+			}
+		}
+	}
+	return wide
+}
+
+// simulate moves the robot around the warehouse, calling observe (unless nil) before
+// the first move and after every move, and returns the boxes' GPS coordinates sum.
+func simulate(g grid.Grid[byte], moves []grid.Direction, observe observer) (int, error) {
+	if observe == nil {
+		observe = func(int, grid.Grid[byte]) error { return nil }
+	}
+
+	robot, ok := findRobot(g)
+	if !ok {
+		return 0, errors.New("grid did not contain robot")
+	}
+	if err := observe(0, g); err != nil {
+		return 0, err
+	}
+	for i, move := range moves {
+		robot = push(g, robot, move)
+		if err := observe(i+1, g); err != nil {
+			return 0, err
+		}
+	}
+	return gpsSum(g), nil
+}
+
+func findRobot(g grid.Grid[byte]) (grid.Coordinate, bool) {
+	for c, v := range g.All() {
+		if v == indicatorRobot {
+			return c, true
+		}
+	}
+	return grid.Coordinate{}, false
+}
+
+// push moves the robot one step in the given direction, along with every box it
+// pushes (directly or through other boxes). When any of them would hit a wall,
+// nothing moves at all. It returns the robot's new position.
+func push(g grid.Grid[byte], robot grid.Coordinate, move grid.Direction) grid.Coordinate {
+	// find everything that moves, breadth-first, such that (along the
+	// direction of the move) every cell comes after the one pushing it
+	moving := []grid.Coordinate{robot}
+	queued := set.New(robot)
+	enqueue := func(c grid.Coordinate) {
+		if !queued.Has(c) {
+			queued.Put(c)
+			moving = append(moving, c)
+		}
+	}
+	for i := 0; i < len(moving); i++ {
+		next := moving[i].Move(move)
+		switch v, _ := g.Get(next); v {
+		case indicatorWall:
+			return robot
+		case indicatorBox:
+			enqueue(next)
+		case indicatorBoxLeft:
+			// a wide box moves as a whole, so both halves push what is in front of them
+			enqueue(next)
+			enqueue(next.Move(grid.East))
+		case indicatorBoxRight:
+			enqueue(next)
+			enqueue(next.Move(grid.West))
+		}
+	}
+
+	// move the furthest first, so nothing is overwritten before it has moved
+	for i := len(moving) - 1; i >= 0; i-- {
+		g.Set(moving[i].Move(move), g[moving[i].Y][moving[i].X])
+		g.Set(moving[i], indicatorEmpty)
+	}
+	return robot.Move(move)
+}
+
+// gpsSum returns the sum of the boxes' GPS coordinates, i.e. 100 times the
+// distance from the top edge plus the distance from the left edge (to the
+// closest edge of the box).
+func gpsSum(g grid.Grid[byte]) int {
+	sum := 0
+	for c, v := range g.All() {
+		if v == indicatorBox || v == indicatorBoxLeft {
+			sum += 100*c.Y + c.X
+		}
+	}
+	return sum
+}
@@ -0,0 +1,79 @@
+package day15
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/render"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+// TestReplay checks every intermediate warehouse of part 2 against the walkthrough in the puzzle.
+func TestReplay(t *testing.T) {
+	f, err := os.Open("sample-input-wide.txt")
+	if err != nil {
+		t.Fatalf("failed to open sample: %v", err)
+	}
+	defer f.Close()
+	in, err := (&Solver{}).Parse(f)
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	want, err := os.ReadFile("sample-replay-wide.txt")
+	if err != nil {
+		t.Fatalf("failed to read replay: %v", err)
+	}
+
+	var got strings.Builder
+	if _, err := simulate(widen(in.warehouse), in.moves, replay(&got, in.moves)); err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	if got.String() != string(want) {
+		t.Errorf("got replay:\n%s\nwant:\n%s", got.String(), want)
+	}
+}
+
+// TestBlockedPush checks that a tree of wide boxes only moves when none of them is blocked.
+func TestBlockedPush(t *testing.T) {
+	before := "#######\n" +
+		"#.#...#\n" +
+		"#.....#\n" +
+		"#.[][]#\n" +
+		"#..[].#\n" +
+		"#..@..#\n" +
+		"#######\n"
+	g, err := grid.ReadByte(strings.NewReader(before))
+	if err != nil {
+		t.Fatalf("failed to read grid: %v", err)
+	}
+
+	robot := push(g, grid.Coordinate{X: 3, Y: 5}, grid.North)
+	after := "#######\n" +
+		"#.#...#\n" +
+		"#.[][]#\n" +
+		"#..[].#\n" +
+		"#..@..#\n" +
+		"#.....#\n" +
+		"#######\n"
+	if got := render.Text(g); got != after || robot != (grid.Coordinate{X: 3, Y: 4}) {
+		t.Fatalf("first push: got robot at %v in\n%s\nwant\n%s", robot, got, after)
+	}
+
+	// the left box of the top row now hits the wall, so none of them can move
+	if robot = push(g, robot, grid.North); robot != (grid.Coordinate{X: 3, Y: 4}) {
+		t.Errorf("second push: got robot at %v, want it to stay at (4,3)", robot)
+	}
+	if got := render.Text(g); got != after {
+		t.Errorf("second push: got\n%s\nwant it unchanged", got)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -1,11 +1,14 @@
-package main
+package day19
 
 import (
 	"bufio"
 	"bytes"
+	"flag"
 	"fmt"
+	"io"
 	"log"
-	"os"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
 type input struct {
@@ -13,35 +16,45 @@ type input struct {
 	designs  [][]byte
 }
 
-func main() {
-	debug := false
+// Solver solves the puzzle for 2024 day 19.
+type Solver struct {
+	Debug bool
+}
 
-	sampleInput, err := loadInput("sample-input.txt")
-	if err != nil {
-		log.Fatalf("failed to load sample input data: %v", err)
-	}
-	log.Printf("[Answer to Sample in Part 1] The result is: %d, should be 6", part1(sampleInput, debug))
-	log.Printf("[Answer to Sample in Part 2] The result is: %d, should be 16", part2(sampleInput, debug))
+// New returns the solver for 2024 day 19.
+func New() solution.Puzzle {
+	return solution.Erase[*input](&Solver{})
+}
 
-	input, err := loadInput("input.txt")
-	if err != nil {
-		log.Fatalf("failed to load input data: %v", err)
-	}
-	log.Printf("[Answer to Part 1] The result is: %d", part1(input, debug))
-	log.Printf("[Answer to Part 2] The result is: %d", part2(input, debug))
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
 }
 
-func loadInput(filename string) (*input, error) {
-	file, err := os.Open(filename)
+// Parse parses the puzzle input's towel patterns and designs.
+func (s *Solver) Parse(r io.Reader) (*input, error) {
+	in, err := loadInput(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %v", err)
+		return nil, fmt.Errorf("failed to load input data: %v", err)
 	}
-	defer file.Close()
+	return in, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(in *input) (solution.Answer, error) {
+	return part1(in, s.Debug), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(in *input) (solution.Answer, error) {
+	return part2(in, s.Debug), nil
+}
 
+func loadInput(r io.Reader) (*input, error) {
 	patterns := [][]byte{}
 	designs := [][]byte{}
 	patternsDone := false
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for lineNo := 0; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
 
@@ -0,0 +1,323 @@
+package day21
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+	"github.com/adrianosela/adventofcode/utils/set"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+const (
+	buttonActivate = byte('A')
+	buttonUp       = byte('^')
+	buttonDown     = byte('v')
+	buttonRight    = byte('>')
+	buttonLeft     = byte('<')
+)
+
+// keypad is a layout of buttons, where every position which is not a button is a gap
+// that the robotic arm aiming at the buttons must never pass over.
+type keypad struct {
+	buttons   map[byte]grid.Coordinate
+	positions set.Set[grid.Coordinate]
+}
+
+// newKeypad returns the keypad with the given rows of buttons (top to bottom), where a space is a gap.
+func newKeypad(rows ...string) keypad {
+	k := keypad{buttons: make(map[byte]grid.Coordinate), positions: set.New[grid.Coordinate]()}
+	for y, row := range rows {
+		for x, button := range []byte(row) {
+			if button != ' ' {
+				k.buttons[button] = grid.Coordinate{X: x, Y: y}
+				k.positions.Put(grid.Coordinate{X: x, Y: y})
+			}
+		}
+	}
+	return k
+}
+
+func (k keypad) isGap(c grid.Coordinate) bool {
+	return !k.positions.Has(c)
+}
+
+var (
+	// +---+---+---+
+	// | 7 | 8 | 9 |
+	// +---+---+---+
+	// | 4 | 5 | 6 |
+	// +---+---+---+
+	// | 1 | 2 | 3 |
+	// +---+---+---+
+	//     | 0 | A |
+	//     +---+---+
+	numPad = newKeypad(
+		"789",
+		"456",
+		"123",
+		" 0A",
+	)
+
+	//     +---+---+
+	//     | ^ | A |
+	// +---+---+---+
+	// | < | v | > |
+	// +---+---+---+
+	dirPad = newKeypad(
+		" ^A",
+		"<v>",
+	)
+)
+
+// Solver solves the puzzle for 2024 day 21.
+type Solver struct {
+	Debug bool
+}
+
+// New returns the solver for 2024 day 21.
+func New() solution.Puzzle {
+	return solution.Erase[[]string](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+}
+
+// Parse parses the puzzle input's door codes.
+func (s *Solver) Parse(r io.Reader) ([]string, error) {
+	codes := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := scanner.Text(); len(line) > 0 {
+			codes = append(codes, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan input file: %v", err)
+	}
+
+	return codes, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(codes []string) (solution.Answer, error) {
+	return solvePart1(codes, s.Debug)
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(codes []string) (solution.Answer, error) {
+	return solvePart2(codes, s.Debug)
+}
+
+func solvePart1(codes []string, debug bool) (int, error) {
+	return solveWithBots(codes, 2, debug)
+}
+
+func solvePart2(codes []string, debug bool) (int, error) {
+	return solveWithBots(codes, 25, debug)
+}
+
+// NOTE: in the problem statement, the fact that there
+// were multiple robots inbetween hinted at needing the
+// ability to handle a variable amount so had that since
+// part1.
+func solveWithBots(codes []string, bots int, debug bool) (int, error) {
+	// the door's numeric keypad is operated by a robot, which is operated
+	// through a directional keypad by the next robot, and so on
+	model := newCostModel(append([]keypad{numPad}, slices.Repeat([]keypad{dirPad}, bots)...))
+
+	sum := 0
+	for i := 0; i < len(codes); i++ {
+		complexity, err := getComplexity(model, codes[i], debug)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get complexity for code at index %d (%s): %v", i, codes[i], err)
+		}
+		sum += complexity
+	}
+	return sum, nil
+}
+
+func getComplexity(model *costModel, code string, debug bool) (int, error) {
+	presses, err := model.presses([]byte(code), 0)
+	if err != nil {
+		return 0, err
+	}
+
+	numericPartOfCode, err := strconv.Atoi(strings.TrimSuffix(code, "A"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get numeric part of code %s: %v", code, err)
+	}
+
+	if debug {
+		log.Printf("code %s takes %d presses", code, presses)
+	}
+	return numericPartOfCode * presses, nil
+}
+
+// costModel finds the fewest presses of the buttons on the keypad in front of
+// you which make the robots type a sequence on the first of a chain of keypads.
+type costModel struct {
+	// keypads are the chain of keypads, each of which is operated by a robot
+	// whose arm is aimed through the next one (and the last one by you).
+	keypads []keypad
+	memo    map[costKey]int
+}
+
+type costKey struct {
+	layer    int
+	from, to byte
+}
+
+func newCostModel(keypads []keypad) *costModel {
+	return &costModel{keypads: keypads, memo: make(map[costKey]int)}
+}
+
+// presses returns the fewest presses needed to type the sequence on the keypad
+// at the given layer, starting (as every arm does) with its arm aimed at A.
+func (m *costModel) presses(seq []byte, layer int) (int, error) {
+	total := 0
+	from := buttonActivate
+	for _, to := range seq {
+		cost, err := m.cost(layer, from, to)
+		if err != nil {
+			return 0, err
+		}
+		total += cost
+		from = to
+	}
+	return total, nil
+}
+
+// cost returns the fewest presses needed for the robot at the given layer to move its
+// arm from one button to another and press it. The arm at the next layer has to be back
+// on A for that (the press), so the cost of each move only depends on the layer and the
+// buttons, and the same moves recur so often that memoizing them makes any number of
+// layers cheap, unlike typing out the sequences which grow exponentially with them.
+func (m *costModel) cost(layer int, from, to byte) (int, error) {
+	if layer == len(m.keypads) {
+		return 1, nil // you press the button directly
+	}
+	key := costKey{layer: layer, from: from, to: to}
+	if cost, ok := m.memo[key]; ok {
+		return cost, nil
+	}
+
+	pad := m.keypads[layer]
+	src, ok := pad.buttons[from]
+	if !ok {
+		return 0, fmt.Errorf("keypad at layer %d has no button %c", layer, from)
+	}
+	dst, ok := pad.buttons[to]
+	if !ok {
+		return 0, fmt.Errorf("keypad at layer %d has no button %c", layer, to)
+	}
+
+	// moving along one axis and then the other is always at least as cheap as
+	// zig-zagging, since repeated presses of a button cost a single press each
+	// at every layer above, so only the two orders need to be compared. When the
+	// gaps block both of them (which they never do on the puzzle's keypads), any
+	// of the shortest routes around the gaps might be the cheapest.
+	routes := [][]byte{}
+	for _, horizontalFirst := range []bool{true, false} {
+		if seq := pad.route(src, dst, horizontalFirst); seq != nil {
+			routes = append(routes, seq)
+		}
+	}
+	if len(routes) == 0 {
+		routes = pad.shortestRoutes(src, dst)
+	}
+
+	lowest := -1
+	for _, seq := range routes {
+		cost, err := m.presses(seq, layer+1)
+		if err != nil {
+			return 0, err
+		}
+		if lowest == -1 || cost < lowest {
+			lowest = cost
+		}
+	}
+	if lowest == -1 {
+		return 0, fmt.Errorf("keypad at layer %d has no route from %c to %c", layer, from, to)
+	}
+	m.memo[key] = lowest
+	return lowest, nil
+}
+
+// route returns the buttons to press on a directional keypad to move an arm on this keypad
+// from src to dst (moving horizontally first, or vertically first) and press the button
+// there, or nil when the arm would pass over a gap on the way.
+func (k keypad) route(src, dst grid.Coordinate, horizontalFirst bool) []byte {
+	first, second := moves(dst.X-src.X, grid.East), moves(dst.Y-src.Y, grid.South)
+	if !horizontalFirst {
+		first, second = second, first
+	}
+
+	pos := src
+	seq := append(append([]byte{}, first...), second...)
+	for _, button := range seq {
+		d, _ := grid.ParseDirection(button)
+		if pos = pos.Move(d); k.isGap(pos) {
+			return nil
+		}
+	}
+	return append(seq, buttonActivate)
+}
+
+// shortestRoutes returns the buttons to press on a directional keypad to move an arm on this keypad
+// from src to dst along each of the shortest paths which avoid the gaps and press the button there.
+// When a path moving only towards dst avoids the gaps, these are all such paths (zig-zagging or not).
+func (k keypad) shortestRoutes(src, dst grid.Coordinate) [][]byte {
+	// the distance of every position from dst, such that each step of a shortest path gets one closer
+	dist := map[grid.Coordinate]int{dst: 0}
+	queue := []grid.Coordinate{dst}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		for _, d := range grid.Directions4 {
+			if n := c.Move(d); !k.isGap(n) {
+				if _, ok := dist[n]; !ok {
+					dist[n] = dist[c] + 1
+					queue = append(queue, n)
+				}
+			}
+		}
+	}
+	if _, ok := dist[src]; !ok {
+		return nil
+	}
+
+	routes := [][]byte{}
+	var follow func(pos grid.Coordinate, seq []byte)
+	follow = func(pos grid.Coordinate, seq []byte) {
+		if pos == dst {
+			routes = append(routes, append(slices.Clone(seq), buttonActivate))
+			return
+		}
+		for _, d := range grid.Directions4 {
+			if n := pos.Move(d); !k.isGap(n) && dist[n] == dist[pos]-1 {
+				follow(n, append(seq, d.Arrow()))
+			}
+		}
+	}
+	follow(src, nil)
+	return routes
+}
+
+// moves returns the buttons to press to move the given distance
+// along the axis of the given direction (backwards when negative).
+func moves(distance int, direction grid.Direction) []byte {
+	if distance < 0 {
+		direction, distance = direction.Opposite(), -distance
+	}
+	return bytes.Repeat([]byte{direction.Arrow()}, distance)
+}
@@ -0,0 +1,90 @@
+package day21
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+func TestSolution(t *testing.T) {
+	solutiontest.Golden(t, New())
+}
+
+// TestPresses checks the lengths of the sequences for 029A in the puzzle's example.
+func TestPresses(t *testing.T) {
+	for bots, want := range []int{12, 28, 68} {
+		model := newCostModel(append([]keypad{numPad}, slices.Repeat([]keypad{dirPad}, bots)...))
+		got, err := model.presses([]byte("029A"), 0)
+		if err != nil {
+			t.Fatalf("unexpected error with %d bots: %v", bots, err)
+		}
+		if got != want {
+			t.Errorf("got %d presses with %d bots, want %d", got, bots, want)
+		}
+	}
+}
+
+func TestCustomKeypad(t *testing.T) {
+	pad := newKeypad(
+		"ab",
+		" c",
+		"dA",
+	)
+	src, dst := pad.buttons['c'], pad.buttons['a']
+	if seq := pad.route(src, dst, true); seq != nil {
+		t.Errorf("expected moving left first to pass over the gap, got %q", seq)
+	}
+	if seq := string(pad.route(src, dst, false)); seq != "^<A" {
+		t.Errorf("got %q moving up first, want \"^<A\"", seq)
+	}
+
+	// pressing the keypad directly, every route has to avoid the gap:
+	// ^^<A (4), >vA (3), v<A (3), >A (2)
+	model := newCostModel([]keypad{pad})
+	got, err := model.presses([]byte("acdA"), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := 12; got != want {
+		t.Errorf("got %d presses, want %d", got, want)
+	}
+
+	if _, err := model.presses([]byte("x"), 0); err == nil {
+		t.Error("expected an error for a button which is not on the keypad")
+	}
+}
+
+func TestZigZagKeypad(t *testing.T) {
+	// moving from A to a and from a to d, both orders of moving along one axis then the other pass over a gap
+	pad := newKeypad(
+		"a  ",
+		"bc ",
+		" dA",
+	)
+	src, dst := pad.buttons['a'], pad.buttons['d']
+	if pad.route(src, dst, true) != nil || pad.route(src, dst, false) != nil {
+		t.Fatal("expected both routes along one axis then the other to pass over a gap")
+	}
+	if routes := pad.shortestRoutes(src, dst); len(routes) != 1 || string(routes[0]) != "v>vA" {
+		t.Errorf("got routes %q, want [\"v>vA\"]", routes)
+	}
+
+	// pressing the keypad directly: <^<^A (5), v>vA (4)
+	got, err := newCostModel([]keypad{pad}).presses([]byte("ad"), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := 9; got != want {
+		t.Errorf("got %d presses, want %d", got, want)
+	}
+
+	// through a directional keypad too, any of the zig-zagging routes can be typed
+	if _, err := newCostModel([]keypad{pad, dirPad}).presses([]byte("ad"), 0); err != nil {
+		t.Errorf("unexpected error through a directional keypad: %v", err)
+	}
+}
+
+func BenchmarkSolution(b *testing.B) {
+	solutiontest.Benchmark(b, New())
+}
@@ -1,40 +1,55 @@
-package main
+package day22
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"math"
-	"os"
 	"strconv"
 
 	"github.com/adrianosela/adventofcode/utils/set"
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
-func main() {
-	sampleInputMini, err := loadInput("sample-input.txt")
-	if err != nil {
-		log.Fatalf("failed to load sample input data: %v", err)
-	}
-	log.Printf("[Answer to Sample in Part 1] The result is: %d (should be 37327623)", part1(sampleInputMini, 2000, false))
+// Solver solves the puzzle for 2024 day 22.
+type Solver struct {
+	Debug bool
+}
 
-	input, err := loadInput("input.txt")
-	if err != nil {
-		log.Fatalf("failed to load input data: %v", err)
-	}
-	log.Printf("[Answer to Part 1] The result is: %d", part1(input, 2000, false))
-	log.Printf("[Answer to Part 2] The result is: %d", part2(input, 4, 2000, false))
+// New returns the solver for 2024 day 22.
+func New() solution.Puzzle {
+	return solution.Erase[[]int](&Solver{})
+}
+
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
 }
 
-func loadInput(filename string) ([]int, error) {
-	file, err := os.Open(filename)
+// Parse parses the puzzle input's initial secret numbers of each buyer.
+func (s *Solver) Parse(r io.Reader) ([]int, error) {
+	buyers, err := loadInput(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %v", err)
+		return nil, fmt.Errorf("failed to load input data: %v", err)
 	}
-	defer file.Close()
+	return buyers, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(buyers []int) (solution.Answer, error) {
+	return part1(buyers, 2000, s.Debug), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(buyers []int) (solution.Answer, error) {
+	return part2(buyers, 4, 2000, s.Debug), nil
+}
 
+func loadInput(r io.Reader) ([]int, error) {
 	buyers := []int{}
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for lineNo := 0; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
 
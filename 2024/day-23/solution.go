@@ -0,0 +1,102 @@
+package day23
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/graph"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+type network struct {
+	names *graph.Interner[string]
+	links *graph.Undirected
+}
+
+// Solver solves the puzzle for 2024 day 23.
+type Solver struct{}
+
+// New returns the solver for 2024 day 23.
+func New() solution.Puzzle {
+	return solution.Erase[*network](Solver{})
+}
+
+// Parse parses the puzzle input's network map.
+func (Solver) Parse(r io.Reader) (*network, error) {
+	n, err := loadInput(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load input data: %v", err)
+	}
+	return n, nil
+}
+
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (Solver) Part1(n *network) (solution.Answer, error) {
+	return part1(n), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (Solver) Part2(n *network) (solution.Answer, error) {
+	return part2(n), nil
+}
+
+func loadInput(r io.Reader) (*network, error) {
+	n := &network{
+		names: graph.NewInterner[string](),
+		links: graph.NewUndirected(0),
+	}
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+
+		nodeIDA, nodeIDB, ok := strings.Cut(line, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid input in line %d: \"%s\" not in the form ${peer_a}-${peer_b}", lineNo, line)
+		}
+		n.links.AddEdge(n.names.ID(nodeIDA), n.names.ID(nodeIDB))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan input file: %v", err)
+	}
+
+	return n, nil
+}
+
+func (n *network) String() string {
+	asMap := map[string][]string{}
+	for id := 0; id < n.links.Len(); id++ {
+		peerIDs := n.names.Keys(n.links.NeighborIDs(id))
+		sort.Strings(peerIDs)
+		asMap[n.names.Key(id)] = peerIDs
+	}
+	byt, err := json.Marshal(&asMap)
+	if err != nil {
+		// cannot ever happen
+		panic(fmt.Errorf("failed to json encode network: %v", err))
+	}
+	return string(byt)
+}
+
+func part1(n *network) int {
+	startsWithT := 0
+	for _, triplet := range graph.Triangles(n.links) {
+		for _, nodeID := range n.names.Keys(triplet[:]) {
+			if strings.HasPrefix(nodeID, "t") {
+				startsWithT++
+				break
+			}
+		}
+	}
+	return startsWithT
+}
+
+func part2(n *network) string {
+	ids := n.names.Keys(graph.MaximumClique(n.links))
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
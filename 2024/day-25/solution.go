@@ -1,12 +1,14 @@
-package main
+package day25
 
 import (
 	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	"log"
-	"os"
 	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
 )
 
 const (
@@ -20,23 +22,45 @@ type input struct {
 	locks [][]int
 }
 
-func main() {
-	filename := flag.String("filename", "", "The path to the input file")
-	schematicHeight := flag.Int("schematic-height", 6, "The height (in characters) of each schematic")
-	debug := flag.Bool("debug", false, "Whether to print debug output or not")
-	flag.Parse()
+// Solver solves the puzzle for 2024 day 25.
+type Solver struct {
+	SchematicHeight int
+	Debug           bool
+}
+
+// New returns the solver for 2024 day 25.
+func New() solution.Puzzle {
+	return solution.Erase[*input](&Solver{SchematicHeight: 6})
+}
 
-	input, err := loadInput(*filename, *schematicHeight)
+// Flags registers the solver's command line flags.
+func (s *Solver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.SchematicHeight, "schematic-height", 6, "The height (in characters) of each schematic")
+	fs.BoolVar(&s.Debug, "debug", false, "Whether to print debug output or not")
+}
+
+// Parse parses the puzzle input's lock and key schematics.
+func (s *Solver) Parse(r io.Reader) (*input, error) {
+	input, err := loadInput(r, s.SchematicHeight)
 	if err != nil {
-		log.Fatalf("failed to load input: %v", err)
+		return nil, fmt.Errorf("failed to load input: %v", err)
 	}
+	return input, nil
+}
 
-	if *debug {
+// Part1 returns the answer to part 1 for the parsed puzzle input.
+func (s *Solver) Part1(input *input) (solution.Answer, error) {
+	if s.Debug {
 		log.Printf("Got keys:  %v", input.keys)
 		log.Printf("Got locks: %v", input.locks)
 	}
 
-	log.Printf("[Answer to Part 1] The number of fit combinations is: %d", fitCombinations(input.keys, input.locks, *schematicHeight))
+	return fitCombinations(input.keys, input.locks, s.SchematicHeight), nil
+}
+
+// Part2 returns the answer to part 2 for the parsed puzzle input.
+func (s *Solver) Part2(input *input) (solution.Answer, error) {
+	return nil, solution.ErrNoPart2
 }
 
 func fitCombinations(keys, locks [][]int, schematicHeight int) int {
@@ -58,13 +82,7 @@ func fitCombinations(keys, locks [][]int, schematicHeight int) int {
 	return sum
 }
 
-func loadInput(path string, schematicHeight int) (*input, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open input file: %v", err)
-	}
-	defer file.Close()
-
+func loadInput(r io.Reader, schematicHeight int) (*input, error) {
 	input := &input{
 		keys:  make([][]int, 0),
 		locks: make([][]int, 0),
@@ -76,7 +94,7 @@ func loadInput(path string, schematicHeight int) (*input, error) {
 	currentKey := 0
 	currentLock := 0
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for lineNo := 0; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
 
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/adrianosela/adventofcode/registry"
+	"github.com/adrianosela/adventofcode/utils/solution"
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+// benchRun is a single run of "aoc bench", as recorded in the history file.
+type benchRun struct {
+	Commit  string        `json:"commit"`
+	Time    time.Time     `json:"time"`
+	Results []benchResult `json:"results"`
+}
+
+// benchResult is the result of benchmarking one phase (parse, part-1 or part-2) of a puzzle.
+type benchResult struct {
+	Year        int    `json:"year"`
+	Day         int    `json:"day"`
+	Phase       string `json:"phase"`
+	N           int    `json:"n"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+}
+
+func bench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	year := fs.Int("year", 0, "Only benchmark puzzles from this year")
+	day := fs.Int("day", 0, "Only benchmark puzzles from this day")
+	history := fs.String("history", "bench-history.json", "The path to the JSON file to compare with and record results in (not recorded when empty)")
+	threshold := fs.Float64("threshold", 10, "The slowdown (in percent) from the previous run above which a result is reported as a regression")
+	fs.Parse(args)
+
+	runs, err := loadHistory(*history)
+	if err != nil {
+		return err
+	}
+
+	run := benchRun{Commit: commit(), Time: time.Now().UTC()}
+	matched := 0
+	for _, puzzle := range registry.All() {
+		if (*year != 0 && puzzle.Year != *year) || (*day != 0 && puzzle.Day != *day) {
+			continue
+		}
+		matched++
+		results, err := benchPuzzle(puzzle)
+		if err != nil {
+			return fmt.Errorf("failed to benchmark year %d day %d: %v", puzzle.Year, puzzle.Day, err)
+		}
+		run.Results = append(run.Results, results...)
+	}
+	if matched == 0 {
+		return fmt.Errorf("no registered puzzles match year %d day %d", *year, *day)
+	}
+	if len(run.Results) == 0 {
+		log.Printf("None of the matching puzzles have anything to benchmark yet")
+		return nil
+	}
+
+	regressions := 0
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PUZZLE\tPHASE\tTIME/OP\tB/OP\tALLOCS/OP\tCHANGE")
+	for _, r := range run.Results {
+		change := "-"
+		if prev, commit, ok := previous(runs, r); ok && prev.NsPerOp > 0 {
+			delta := 100 * float64(r.NsPerOp-prev.NsPerOp) / float64(prev.NsPerOp)
+			change = fmt.Sprintf("%+.1f%% (vs %s)", delta, commit)
+			if delta > *threshold {
+				change += " REGRESSION"
+				regressions++
+			}
+		}
+		fmt.Fprintf(tw, "%d day %d\t%s\t%s\t%d\t%d\t%s\n",
+			r.Year, r.Day, r.Phase, time.Duration(r.NsPerOp), r.BytesPerOp, r.AllocsPerOp, change)
+	}
+	tw.Flush()
+
+	if regressions > 0 {
+		log.Printf("%d result(s) slower than the previous run by more than %.1f%%", regressions, *threshold)
+	}
+
+	if *history == "" {
+		return nil
+	}
+	if err := saveHistory(*history, append(runs, run)); err != nil {
+		return err
+	}
+	log.Printf("Recorded results for commit %s in %s", run.Commit, *history)
+	return nil
+}
+
+// benchPuzzle benchmarks parsing the puzzle's input and solving each of its parts
+// with a golden answer, such that slow or unsolved parts are left out. Puzzles without
+// an input file or any golden answers for it (e.g. new ones) are skipped.
+func benchPuzzle(puzzle registry.Puzzle) ([]benchResult, error) {
+	p := puzzle.New()
+
+	golden, err := solution.LoadGolden(filepath.Join(puzzle.Dir, solutiontest.AnswersFile))
+	if err != nil {
+		return nil, err
+	}
+	parts := solutiontest.Parts(golden, solutiontest.BenchInput)
+	if len(parts) == 0 {
+		log.Printf("Skipping year %d day %d: no golden answers for %s yet", puzzle.Year, puzzle.Day, solutiontest.BenchInput)
+		return nil, nil
+	}
+	data, err := os.ReadFile(filepath.Join(puzzle.Dir, solutiontest.BenchInput))
+	if errors.Is(err, os.ErrNotExist) {
+		log.Printf("Skipping year %d day %d: no %s yet", puzzle.Year, puzzle.Day, solutiontest.BenchInput)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input file: %v", err)
+	}
+	in, err := p.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input: %v", err)
+	}
+
+	benchmarks := map[string]func(b *testing.B){"parse": solutiontest.BenchmarkParse(p, data)}
+	phases := []string{"parse"}
+	for _, part := range parts {
+		phase := fmt.Sprintf("part-%d", part)
+		benchmarks[phase] = solutiontest.BenchmarkPart(p, in, part)
+		phases = append(phases, phase)
+	}
+
+	results := []benchResult{}
+	for _, phase := range phases {
+		r := testing.Benchmark(benchmarks[phase])
+		if r.N == 0 {
+			return nil, fmt.Errorf("benchmark of %s failed", phase)
+		}
+		results = append(results, benchResult{
+			Year:        puzzle.Year,
+			Day:         puzzle.Day,
+			Phase:       phase,
+			N:           r.N,
+			NsPerOp:     r.NsPerOp(),
+			BytesPerOp:  r.AllocedBytesPerOp(),
+			AllocsPerOp: r.AllocsPerOp(),
+		})
+	}
+	return results, nil
+}
+
+// previous returns the most recent result for the same puzzle and
+// phase in the given runs, along with the commit it was recorded at.
+func previous(runs []benchRun, r benchResult) (benchResult, string, bool) {
+	for i := len(runs) - 1; i >= 0; i-- {
+		for _, prev := range runs[i].Results {
+			if prev.Year == r.Year && prev.Day == r.Day && prev.Phase == r.Phase {
+				return prev, runs[i].Commit, true
+			}
+		}
+	}
+	return benchResult{}, "", false
+}
+
+// loadHistory loads the benchmark runs in the given history file, if any.
+func loadHistory(filename string) ([]benchRun, error) {
+	if filename == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history file: %v", err)
+	}
+
+	runs := []benchRun{}
+	if err := json.Unmarshal(data, &runs); err != nil {
+		return nil, fmt.Errorf("failed to decode history file: %v", err)
+	}
+	return runs, nil
+}
+
+// saveHistory writes the given benchmark runs to the given history file.
+func saveHistory(filename string, runs []benchRun) error {
+	data, err := json.MarshalIndent(runs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode history: %v", err)
+	}
+	if err := os.WriteFile(filename, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write history file: %v", err)
+	}
+	return nil
+}
+
+// commit returns the abbreviated hash of the checked out commit, suffixed
+// with "-dirty" when the working tree has uncommitted changes.
+func commit() string {
+	out, err := exec.Command("git", "rev-parse", "--short", "HEAD").Output()
+	if err != nil {
+		return "unknown"
+	}
+	hash := strings.TrimSpace(string(out))
+
+	status, err := exec.Command("git", "status", "--porcelain").Output()
+	if err == nil && strings.TrimSpace(string(status)) != "" {
+		hash += "-dirty"
+	}
+	return hash
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/registry"
+	"github.com/adrianosela/adventofcode/utils/aoc"
+)
+
+// newClient returns a client for the Advent of Code website, logged in with the session
+// cookie in the AOC_SESSION environment variable or else the "aoc/session" file in the
+// user's config directory, and caching responses in the given directory.
+func newClient(cacheDir string) (*aoc.Client, error) {
+	session := strings.TrimSpace(os.Getenv("AOC_SESSION"))
+	if session == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find config directory: %v", err)
+		}
+		data, err := os.ReadFile(filepath.Join(configDir, "aoc", "session"))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read session file: %v", err)
+		}
+		session = strings.TrimSpace(string(data))
+	}
+	return aoc.NewClient(session, cacheDir), nil
+}
+
+// defaultCacheDir returns the directory responses from the Advent of Code website are
+// cached in, which is the AOC_CACHE_DIR environment variable or else "aoc" in the user's
+// cache directory.
+func defaultCacheDir() string {
+	if dir := os.Getenv("AOC_CACHE_DIR"); dir != "" {
+		return dir
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(cacheDir, "aoc")
+}
+
+// puzzleDir returns the directory of the puzzle for the given year and
+// day, which is its registered directory for puzzles with a solution.
+func puzzleDir(year, day int) string {
+	if puzzle, ok := registry.Lookup(year, day); ok {
+		return puzzle.Dir
+	}
+	return filepath.Join(fmt.Sprint(year), fmt.Sprintf("day-%02d", day))
+}
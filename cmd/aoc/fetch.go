@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+func fetch(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	year := fs.Int("year", 0, "The year of the puzzle")
+	day := fs.Int("day", 0, "The day of the puzzle")
+	dir := fs.String("dir", "", "The directory to write input.txt and prompt.txt to (defaults to the puzzle's directory)")
+	cacheDir := fs.String("cache", defaultCacheDir(), "The directory to cache responses from the website in")
+	force := fs.Bool("force", false, "Whether to overwrite input.txt and prompt.txt if they already exist")
+	promptOnly := fs.Bool("prompt-only", false, "Only fetch the prompt, overwriting prompt.txt (e.g. to add part 2 once it is unlocked)")
+	fs.Parse(args)
+
+	if *year == 0 || *day < 1 || *day > 25 {
+		return fmt.Errorf("a year and a day (1 to 25) are required, got year %d day %d", *year, *day)
+	}
+	if *dir == "" {
+		*dir = puzzleDir(*year, *day)
+	}
+
+	if !*force && !*promptOnly {
+		for _, name := range []string{"input.txt", "prompt.txt"} {
+			if _, err := os.Stat(filepath.Join(*dir, name)); err == nil {
+				return fmt.Errorf("%s already exists in %s (use --force to overwrite it, or --prompt-only to only refresh prompt.txt)", name, *dir)
+			}
+		}
+	}
+
+	client, err := newClient(*cacheDir)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	files := map[string][]byte{}
+	if !*promptOnly {
+		input, err := client.Input(ctx, *year, *day)
+		if err != nil {
+			return err
+		}
+		files["input.txt"] = input
+	}
+	prompt, err := client.Prompt(ctx, *year, *day)
+	if err != nil {
+		return err
+	}
+	files["prompt.txt"] = []byte(prompt)
+
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		return fmt.Errorf("failed to create puzzle directory: %v", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if err := os.WriteFile(filepath.Join(*dir, name), files[name], 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %v", name, err)
+		}
+		log.Printf("Wrote %s to %s", name, *dir)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/adrianosela/adventofcode/registry"
+)
+
+func list(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	year := fs.Int("year", 0, "Only list puzzles from this year")
+	fs.Parse(args)
+
+	for _, puzzle := range registry.All() {
+		if *year != 0 && puzzle.Year != *year {
+			continue
+		}
+		fmt.Printf("%d day %-2d  %s\n", puzzle.Year, puzzle.Day, puzzle.Dir)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+const usage = `Usage: aoc <command> [flags]
+
+Commands:
+  run    Solve a puzzle (e.g. aoc run --year 2024 --day 13 --part 2)
+  list   List all the puzzles with a registered solution
+  new    Create a new puzzle from a template (e.g. aoc new --year 2024 --day 16 --template grid)
+  fetch  Download a puzzle's input and prompt (e.g. aoc fetch --year 2024 --day 16)
+  submit Submit the answer to a puzzle (e.g. aoc submit --year 2024 --day 16 --part 1)
+  bench  Benchmark the solutions and compare with previous runs (e.g. aoc bench --year 2024)
+
+Flags specific to a puzzle go after a "--" separator, e.g.
+  aoc run --year 2024 --day 10 -- --trail-end 8
+
+Fetching puzzles and submitting answers requires the session cookie of a logged in user, which is
+read from the AOC_SESSION environment variable or else the "aoc/session"
+file in the user's config directory.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "run":
+		err = run(args)
+	case "list":
+		err = list(args)
+	case "new":
+		err = newDay(args)
+	case "fetch":
+		err = fetch(args)
+	case "submit":
+		err = submit(args)
+	case "bench":
+		err = bench(args)
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command \"%s\"\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("%s: %v", os.Args[1], err)
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/adrianosela/adventofcode/registry"
+)
+
+// registryFile is the file puzzles are registered in, relative to the root of the repository.
+const registryFile = "registry/registry.go"
+
+//go:embed templates
+var templates embed.FS
+
+// templateData is the data the templates of a new puzzle are executed with.
+type templateData struct {
+	Year    int
+	Day     int
+	Package string
+}
+
+var registeredRegex = regexp.MustCompile(`^\s*\{Year: (\d+), Day: (\d+),`)
+
+func newDay(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	year := fs.Int("year", 0, "The year of the puzzle")
+	day := fs.Int("day", 0, "The day of the puzzle")
+	variant := fs.String("template", "ints", "The kind of puzzle input to parse: \"grid\", \"ints\" (lines of integers) or \"blocks\" (blank line separated blocks of lines)")
+	dir := fs.String("dir", "", "The directory to create (defaults to the year's directory, e.g. 2024/day-16)")
+	register := fs.Bool("register", true, "Whether to register the new puzzle in "+registryFile)
+	fetchInput := fs.Bool("fetch", false, "Whether to also fetch the puzzle's input and prompt (see aoc fetch)")
+	fs.Parse(args)
+
+	if *year == 0 || *day < 1 || *day > 25 {
+		return fmt.Errorf("a year and a day (1 to 25) are required, got year %d day %d", *year, *day)
+	}
+	if _, ok := registry.Lookup(*year, *day); ok {
+		return fmt.Errorf("year %d day %d already has a registered solution", *year, *day)
+	}
+	if _, err := templates.ReadDir(path.Join("templates", *variant)); err != nil {
+		return fmt.Errorf("unknown template \"%s\" (must be grid, ints or blocks)", *variant)
+	}
+	if *dir == "" {
+		*dir = puzzleDir(*year, *day)
+	}
+
+	data := templateData{Year: *year, Day: *day, Package: fmt.Sprintf("day%02d", *day)}
+	if err := generate(*dir, *variant, data); err != nil {
+		return err
+	}
+	log.Printf("Created %s from the %s template", *dir, *variant)
+
+	if *register {
+		if err := registerPuzzle(data, *dir); err != nil {
+			return err
+		}
+		log.Printf("Registered year %d day %d in %s", *year, *day, registryFile)
+	}
+
+	if *fetchInput {
+		return fetch([]string{"--year", strconv.Itoa(*year), "--day", strconv.Itoa(*day), "--dir", *dir})
+	}
+	return nil
+}
+
+// generate creates the given directory with the files of the given template
+// variant and the files common to every variant, refusing to overwrite any.
+func generate(dir, variant string, data templateData) error {
+	if _, err := os.Stat(filepath.Join(dir, "solution.go")); err == nil {
+		return fmt.Errorf("%s already has a solution", dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create puzzle directory: %v", err)
+	}
+
+	for _, templateDir := range []string{path.Join("templates", variant), "templates/common"} {
+		entries, err := templates.ReadDir(templateDir)
+		if err != nil {
+			return fmt.Errorf("failed to read templates: %v", err)
+		}
+		for _, entry := range entries {
+			name := strings.TrimSuffix(entry.Name(), ".tmpl")
+			tmpl, err := template.ParseFS(templates, path.Join(templateDir, entry.Name()))
+			if err != nil {
+				return fmt.Errorf("failed to parse template for %s: %v", name, err)
+			}
+
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return fmt.Errorf("failed to execute template for %s: %v", name, err)
+			}
+
+			target := filepath.Join(dir, name)
+			if _, err := os.Stat(target); err == nil {
+				// keep files which already exist, e.g. a fetched input.txt
+				continue
+			}
+			if err := os.WriteFile(target, buf.Bytes(), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// registerPuzzle adds the puzzle in the given directory to the registry, importing
+// its package and adding it to the registered puzzles in order of year and day.
+func registerPuzzle(data templateData, dir string) error {
+	src, err := os.ReadFile(registryFile)
+	if err != nil {
+		return fmt.Errorf("failed to read registry: %v", err)
+	}
+
+	alias := fmt.Sprintf("y%d%s", data.Year, data.Package)
+	importLine := fmt.Sprintf("\t%s \"github.com/adrianosela/adventofcode/%s\"", alias, filepath.ToSlash(dir))
+	entryLine := fmt.Sprintf("\t{Year: %d, Day: %d, Dir: \"%s\", New: %s.New},", data.Year, data.Day, filepath.ToSlash(dir), alias)
+
+	lines := strings.Split(string(src), "\n")
+	imported, inPuzzles, registered := false, false, false
+	for i := 0; i < len(lines) && !registered; i++ {
+		switch {
+		case !imported && strings.HasPrefix(lines[i], "\ty") && strings.Contains(lines[i], "\"github.com/"):
+			// imports are sorted by go/format below, so they only need to be in the right block
+			lines = insert(lines, i, importLine)
+			imported = true
+		case strings.HasPrefix(lines[i], "var puzzles = "):
+			inPuzzles = true
+		case inPuzzles && (lines[i] == "}" || isRegisteredAfter(lines[i], data)):
+			lines = insert(lines, i, entryLine)
+			registered = true
+		}
+	}
+	if !registered {
+		return errors.New("failed to find where to register the puzzle in the registry")
+	}
+
+	formatted, err := format.Source([]byte(strings.Join(lines, "\n")))
+	if err != nil {
+		return fmt.Errorf("failed to format registry: %v", err)
+	}
+	if err := os.WriteFile(registryFile, formatted, 0644); err != nil {
+		return fmt.Errorf("failed to write registry: %v", err)
+	}
+	return nil
+}
+
+// isRegisteredAfter returns true when the given line of the registry
+// registers a puzzle which comes after the given one.
+func isRegisteredAfter(line string, data templateData) bool {
+	m := registeredRegex.FindStringSubmatch(line)
+	if m == nil {
+		return false
+	}
+	year, _ := strconv.Atoi(m[1])
+	day, _ := strconv.Atoi(m[2])
+	return year > data.Year || (year == data.Year && day > data.Day)
+}
+
+func insert(lines []string, i int, line string) []string {
+	return append(lines[:i], append([]string{line}, lines[i:]...)...)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/adrianosela/adventofcode/registry"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	year := fs.Int("year", 0, "The year of the puzzle")
+	day := fs.Int("day", 0, "The day of the puzzle")
+	part := fs.Int("part", 0, "The part of the puzzle to solve (both when zero)")
+	input := fs.String("input", "", "The path to the input file, or \"-\" for stdin (defaults to input.txt in the puzzle's directory)")
+	fs.Parse(args)
+
+	puzzle, ok := registry.Lookup(*year, *day)
+	if !ok {
+		return fmt.Errorf("no solution registered for year %d day %d", *year, *day)
+	}
+
+	p, err := newPuzzle(puzzle, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	if *input == "" {
+		*input = filepath.Join(puzzle.Dir, "input.txt")
+	}
+
+	start := time.Now()
+	in, err := parse(p, *input)
+	if err != nil {
+		return fmt.Errorf("failed to parse input: %v", err)
+	}
+	log.Printf("Parsed input in %s", time.Since(start))
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+	for _, part := range parts {
+		start := time.Now()
+		answer, err := solution.Solve(p, in, part)
+		if err != nil {
+			return fmt.Errorf("failed to solve part %d: %v", part, err)
+		}
+		log.Printf("[Answer to Part %d] %v (solved in %s)", part, answer, time.Since(start))
+	}
+	return nil
+}
+
+// newPuzzle returns a new solution for the given puzzle, with any
+// puzzle-specific flags parsed from the given arguments.
+func newPuzzle(puzzle registry.Puzzle, args []string) (solution.Puzzle, error) {
+	p := puzzle.New()
+
+	flagger, ok := solution.FlaggerOf(p)
+	if !ok {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("year %d day %d does not take any flags, got %v", puzzle.Year, puzzle.Day, args)
+		}
+		return p, nil
+	}
+
+	fs := flag.NewFlagSet(fmt.Sprintf("year %d day %d", puzzle.Year, puzzle.Day), flag.ExitOnError)
+	flagger.Flags(fs)
+	fs.Parse(args)
+
+	return p, nil
+}
+
+// parse parses the puzzle input in the given file, or from stdin when the filename is "-".
+func parse(p solution.Puzzle, filename string) (solution.Input, error) {
+	if filename == "-" {
+		return p.Parse(os.Stdin)
+	}
+	return solution.ParseFile(p, filename)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/adrianosela/adventofcode/registry"
+	"github.com/adrianosela/adventofcode/utils/aoc"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+func submit(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	year := fs.Int("year", 0, "The year of the puzzle")
+	day := fs.Int("day", 0, "The day of the puzzle")
+	part := fs.Int("part", 0, "The part of the puzzle to submit the answer to")
+	input := fs.String("input", "", "The path to the input file, or \"-\" for stdin (defaults to input.txt in the puzzle's directory)")
+	answer := fs.String("answer", "", "The answer to submit (solved with the registered solution when empty)")
+	ledgerPath := fs.String("ledger", defaultLedgerPath(), "The path to the ledger of submitted answers")
+	fs.Parse(args)
+
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("invalid part %d (must be 1 or 2)", *part)
+	}
+
+	if *answer == "" {
+		solved, err := solveForSubmission(*year, *day, *part, *input, fs.Args())
+		if err != nil {
+			return err
+		}
+		*answer = fmt.Sprint(solved)
+	}
+
+	ledger, err := aoc.LoadLedger(*ledgerPath)
+	if err != nil {
+		return err
+	}
+	warning, err := ledger.Check(*year, *day, *part, *answer)
+	if err != nil {
+		return fmt.Errorf("refusing to submit %s: %v", *answer, err)
+	}
+	if warning != "" {
+		log.Printf("Warning: %s", warning)
+	}
+
+	client, err := newClient("")
+	if err != nil {
+		return err
+	}
+	log.Printf("Submitting %s as the answer to year %d day %d part %d", *answer, *year, *day, *part)
+	result, err := client.Submit(context.Background(), *year, *day, *part, *answer)
+	if err != nil {
+		return err
+	}
+
+	attempt := aoc.Attempt{Year: *year, Day: *day, Part: *part, Answer: *answer, Verdict: result.Verdict, Time: time.Now().UTC()}
+	if err := ledger.Record(attempt); err != nil {
+		return err
+	}
+
+	log.Printf("[%s] %s", result.Verdict, result.Message)
+	if result.Wait > 0 {
+		log.Printf("Wait %s before submitting another answer", result.Wait)
+	}
+	if result.Verdict == aoc.Correct {
+		log.Printf("Add \"input.txt %d %s\" to the puzzle's answers.txt to test against it", *part, *answer)
+	}
+	return nil
+}
+
+// solveForSubmission solves the given part of the given puzzle with its registered solution.
+func solveForSubmission(year, day, part int, input string, args []string) (solution.Answer, error) {
+	puzzle, ok := registry.Lookup(year, day)
+	if !ok {
+		return nil, fmt.Errorf("no solution registered for year %d day %d (use --answer)", year, day)
+	}
+	p, err := newPuzzle(puzzle, args)
+	if err != nil {
+		return nil, err
+	}
+	if input == "" {
+		input = filepath.Join(puzzle.Dir, "input.txt")
+	}
+
+	in, err := parse(p, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input: %v", err)
+	}
+	answer, err := solution.Solve(p, in, part)
+	if err != nil {
+		return nil, fmt.Errorf("failed to solve part %d: %v", part, err)
+	}
+	return answer, nil
+}
+
+// defaultLedgerPath returns the path of the ledger of submitted answers,
+// which is "aoc/ledger.json" in the user's config directory.
+func defaultLedgerPath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "ledger.json"
+	}
+	return filepath.Join(configDir, "aoc", "ledger.json")
+}
@@ -0,0 +1,94 @@
+package registry
+
+import (
+	"sort"
+
+	y2023day01 "github.com/adrianosela/adventofcode/2023/day-01"
+	y2023day04 "github.com/adrianosela/adventofcode/2023/day-04"
+	y2023day05 "github.com/adrianosela/adventofcode/2023/day-05"
+	y2023day07 "github.com/adrianosela/adventofcode/2023/day-07"
+	y2023day09 "github.com/adrianosela/adventofcode/2023/day-09"
+	y2024day01 "github.com/adrianosela/adventofcode/2024/day-01"
+	y2024day02 "github.com/adrianosela/adventofcode/2024/day-02"
+	y2024day03 "github.com/adrianosela/adventofcode/2024/day-03"
+	y2024day04 "github.com/adrianosela/adventofcode/2024/day-04"
+	y2024day05 "github.com/adrianosela/adventofcode/2024/day-05"
+	y2024day06 "github.com/adrianosela/adventofcode/2024/day-06"
+	y2024day07 "github.com/adrianosela/adventofcode/2024/day-07"
+	y2024day08 "github.com/adrianosela/adventofcode/2024/day-08"
+	y2024day10 "github.com/adrianosela/adventofcode/2024/day-10"
+	y2024day11 "github.com/adrianosela/adventofcode/2024/day-11"
+	y2024day12 "github.com/adrianosela/adventofcode/2024/day-12"
+	y2024day13 "github.com/adrianosela/adventofcode/2024/day-13"
+	y2024day14 "github.com/adrianosela/adventofcode/2024/day-14"
+	y2024day15 "github.com/adrianosela/adventofcode/2024/day-15"
+	y2024day19 "github.com/adrianosela/adventofcode/2024/day-19"
+	y2024day21 "github.com/adrianosela/adventofcode/2024/day-21"
+	y2024day22 "github.com/adrianosela/adventofcode/2024/day-22"
+	y2024day23 "github.com/adrianosela/adventofcode/2024/day-23"
+	y2024day25 "github.com/adrianosela/adventofcode/2024/day-25"
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// Puzzle is a registered puzzle solution.
+type Puzzle struct {
+	Year int
+	Day  int
+
+	// Dir is the directory with the puzzle's input
+	// files, relative to the root of the repository.
+	Dir string
+
+	// New returns a new solution for the puzzle.
+	New func() solution.Puzzle
+}
+
+var puzzles = []Puzzle{
+	{Year: 2023, Day: 1, Dir: "2023/day-01", New: y2023day01.New},
+	{Year: 2023, Day: 4, Dir: "2023/day-04", New: y2023day04.New},
+	{Year: 2023, Day: 5, Dir: "2023/day-05", New: y2023day05.New},
+	{Year: 2023, Day: 7, Dir: "2023/day-07", New: y2023day07.New},
+	{Year: 2023, Day: 9, Dir: "2023/day-09", New: y2023day09.New},
+	{Year: 2024, Day: 1, Dir: "2024/day-01", New: y2024day01.New},
+	{Year: 2024, Day: 2, Dir: "2024/day-02", New: y2024day02.New},
+	{Year: 2024, Day: 3, Dir: "2024/day-03", New: y2024day03.New},
+	{Year: 2024, Day: 4, Dir: "2024/day-04", New: y2024day04.New},
+	{Year: 2024, Day: 5, Dir: "2024/day-05", New: y2024day05.New},
+	{Year: 2024, Day: 6, Dir: "2024/day-06", New: y2024day06.New},
+	{Year: 2024, Day: 7, Dir: "2024/day-07", New: y2024day07.New},
+	{Year: 2024, Day: 8, Dir: "2024/day-08", New: y2024day08.New},
+	{Year: 2024, Day: 10, Dir: "2024/day-10", New: y2024day10.New},
+	{Year: 2024, Day: 11, Dir: "2024/day-11", New: y2024day11.New},
+	{Year: 2024, Day: 12, Dir: "2024/day-12", New: y2024day12.New},
+	{Year: 2024, Day: 13, Dir: "2024/day-13", New: y2024day13.New},
+	{Year: 2024, Day: 14, Dir: "2024/day-14", New: y2024day14.New},
+	{Year: 2024, Day: 15, Dir: "2024/day-15", New: y2024day15.New},
+	{Year: 2024, Day: 19, Dir: "2024/day-19", New: y2024day19.New},
+	{Year: 2024, Day: 21, Dir: "2024/day-21", New: y2024day21.New},
+	{Year: 2024, Day: 22, Dir: "2024/day-22", New: y2024day22.New},
+	{Year: 2024, Day: 23, Dir: "2024/day-23", New: y2024day23.New},
+	{Year: 2024, Day: 25, Dir: "2024/day-25", New: y2024day25.New},
+}
+
+// All returns every registered puzzle, ordered by year and day.
+func All() []Puzzle {
+	all := make([]Puzzle, len(puzzles))
+	copy(all, puzzles)
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Year != all[j].Year {
+			return all[i].Year < all[j].Year
+		}
+		return all[i].Day < all[j].Day
+	})
+	return all
+}
+
+// Lookup returns the registered puzzle for the given year and day.
+func Lookup(year, day int) (Puzzle, bool) {
+	for _, p := range puzzles {
+		if p.Year == year && p.Day == day {
+			return p, true
+		}
+	}
+	return Puzzle{}, false
+}
@@ -0,0 +1,19 @@
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution/solutiontest"
+)
+
+// TestGoldenAnswers ensures that no puzzle is registered without golden answers to test it against.
+func TestGoldenAnswers(t *testing.T) {
+	for _, puzzle := range All() {
+		answers := filepath.Join("..", puzzle.Dir, solutiontest.AnswersFile)
+		if _, err := os.Stat(answers); err != nil {
+			t.Errorf("year %d day %d has no golden answers: %v", puzzle.Year, puzzle.Day, err)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the URL of the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent identifies the client to the Advent of Code website, as its maintainer asks automated tools to.
+	DefaultUserAgent = "github.com/adrianosela/adventofcode/cmd/aoc"
+	// DefaultMinInterval is the minimum time between requests to the website.
+	DefaultMinInterval = 3 * time.Second
+)
+
+// ErrNoSession is returned when a request needs a session cookie but the client has none.
+var ErrNoSession = errors.New("no session cookie configured (set AOC_SESSION)")
+
+// Client is an HTTP client for the Advent of Code website. Puzzle inputs (and prompts
+// once both of their parts are unlocked) are cached on disk such that each is only
+// ever downloaded once, and requests are spaced out by at least MinInterval. The time
+// of the last request is kept in the cache directory, such that requests are spaced
+// out across runs of the command too (though not between processes running at once).
+type Client struct {
+	// BaseURL is the URL of the website, which tests point at a stand-in server.
+	BaseURL string
+	// Session is the value of the website's "session" cookie of a logged in user.
+	Session string
+	// UserAgent is sent with every request.
+	UserAgent string
+	// CacheDir is the directory responses are cached in (not cached when empty).
+	CacheDir string
+	// MinInterval is the minimum time between requests.
+	MinInterval time.Duration
+	// HTTPClient is the client requests are sent with.
+	HTTPClient *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewClient returns a client for the Advent of Code website, logged in with
+// the given session cookie and caching responses in the given directory.
+func NewClient(session, cacheDir string) *Client {
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Session:     session,
+		UserAgent:   DefaultUserAgent,
+		CacheDir:    cacheDir,
+		MinInterval: DefaultMinInterval,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Input returns the puzzle input for the given year and day.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	cached := c.cachePath(year, day, "input.txt")
+	if data, ok := readCache(cached); ok {
+		return data, nil
+	}
+
+	data, err := c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch input: %w", err)
+	}
+	if err := writeCache(cached, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Prompt returns the puzzle description for the given year and day, converted to
+// plain text. Only prompts which include part 2 are cached, since the second part
+// of a prompt is revealed (and must be fetched again) once part 1 is solved.
+func (c *Client) Prompt(ctx context.Context, year, day int) (string, error) {
+	cached := c.cachePath(year, day, "prompt.html")
+	page, ok := readCache(cached)
+	if !ok {
+		var err error
+		if page, err = c.get(ctx, fmt.Sprintf("/%d/day/%d", year, day)); err != nil {
+			return "", fmt.Errorf("failed to fetch prompt: %w", err)
+		}
+	}
+
+	text, err := PromptText(string(page))
+	if err != nil {
+		return "", err
+	}
+	if !ok && strings.Contains(text, partTwoHeader) {
+		if err := writeCache(cached, page); err != nil {
+			return "", err
+		}
+	}
+	return text, nil
+}
+
+// get sends a GET request for the given path of the website and returns the response body.
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %v", err)
+	}
+	return c.do(req)
+}
+
+// do sends the given request, logged in and rate limited, and returns the response body.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	if err := c.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("puzzle not found (is it unlocked yet?): %s", resp.Status)
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusInternalServerError:
+		// the website responds with one of these to expired or invalid session cookies
+		return nil, fmt.Errorf("request rejected (is the session cookie valid?): %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	default:
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+}
+
+// lastRequestFile is the name of the file in the cache directory with the time of the last request.
+const lastRequestFile = "last-request"
+
+// wait blocks until at least MinInterval has passed since the previous request,
+// whether by this client or (as recorded in the cache directory) an earlier one.
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if last, ok := c.lastRequest(); ok && last.After(c.last) {
+		c.last = last
+	}
+	if delay := time.Until(c.last.Add(c.MinInterval)); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	c.last = time.Now()
+	if c.CacheDir == "" {
+		return nil
+	}
+	return writeCache(filepath.Join(c.CacheDir, lastRequestFile), []byte(c.last.Format(time.RFC3339Nano)))
+}
+
+// lastRequest returns the time of the last request recorded in the cache directory, if any.
+func (c *Client) lastRequest() (time.Time, bool) {
+	if c.CacheDir == "" {
+		return time.Time{}, false
+	}
+	data, ok := readCache(filepath.Join(c.CacheDir, lastRequestFile))
+	if !ok {
+		return time.Time{}, false
+	}
+	last, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data)))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return last, true
+}
+
+// cachePath returns the path of the cached file with the given name
+// for the given puzzle, or an empty string when caching is disabled.
+func (c *Client) cachePath(year, day int, name string) string {
+	if c.CacheDir == "" {
+		return ""
+	}
+	return filepath.Join(c.CacheDir, fmt.Sprint(year), fmt.Sprintf("day-%02d", day), name)
+}
+
+func readCache(path string) ([]byte, bool) {
+	if path == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+func writeCache(path string, data []byte) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	testSession = "53616c7465645f5f"
+
+	partOnePage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 1: Historian Hysteria ---</h2><p>The lists are <em>not</em> very similar &amp; need <code>reconciling</code>:</p>
+<pre><code>3   4
+4   3
+</code></pre>
+<p>What is the total distance?</p>
+<ul>
+<li>First item</li>
+<li>Second item</li>
+</ul>
+</article>
+<p>Your puzzle answer was <code>11</code>.</p>
+</main></body></html>`
+
+	partTwoPage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 1: Historian Hysteria ---</h2><p>Part one.</p>
+</article>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2><p>Part two.</p>
+</article>
+</main></body></html>`
+)
+
+// newTestClient returns a client for a stand-in server with the given handler,
+// which only serves requests with the test session cookie and user agent.
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *int) {
+	t.Helper()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != testSession {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.UserAgent() != DefaultUserAgent {
+			http.Error(w, "missing user agent", http.StatusForbidden)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(testSession, t.TempDir())
+	c.BaseURL = server.URL
+	c.MinInterval = 0
+	return c, &requests
+}
+
+func TestInputIsCached(t *testing.T) {
+	c, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024/day/1/input" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("3   4\n4   3\n"))
+	})
+
+	for i := 0; i < 2; i++ {
+		input, err := c.Input(context.Background(), 2024, 1)
+		if err != nil {
+			t.Fatalf("failed to fetch input: %v", err)
+		}
+		if string(input) != "3   4\n4   3\n" {
+			t.Errorf("got input %q", input)
+		}
+	}
+	if *requests != 1 {
+		t.Errorf("expected 1 request to the server, got %d", *requests)
+	}
+	if _, err := os.Stat(filepath.Join(c.CacheDir, "2024", "day-01", "input.txt")); err != nil {
+		t.Errorf("input was not cached: %v", err)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	c, requests := newTestClient(t, http.NotFound)
+
+	if _, err := c.Input(context.Background(), 2024, 26); err == nil {
+		t.Error("expected an error for a puzzle which does not exist")
+	}
+
+	c.Session = "expired"
+	if _, err := c.Input(context.Background(), 2024, 1); err == nil {
+		t.Error("expected an error for an invalid session cookie")
+	}
+
+	c.Session = ""
+	if _, err := c.Input(context.Background(), 2024, 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("expected ErrNoSession without a session cookie, got %v", err)
+	}
+	if *requests != 2 {
+		t.Errorf("expected 2 requests to the server, got %d", *requests)
+	}
+}
+
+func TestPromptText(t *testing.T) {
+	text, err := PromptText(partOnePage)
+	if err != nil {
+		t.Fatalf("failed to convert prompt: %v", err)
+	}
+
+	want := `--- Day 1: Historian Hysteria ---
+The lists are not very similar & need reconciling:
+
+3   4
+4   3
+What is the total distance?
+
+First item
+Second item
+`
+	if text != want {
+		t.Errorf("got prompt text:\n%s\nwant:\n%s", text, want)
+	}
+
+	if _, err := PromptText("<html></html>"); err == nil {
+		t.Error("expected an error for a page without a puzzle description")
+	}
+}
+
+func TestPromptIsCachedOnceComplete(t *testing.T) {
+	page := partOnePage
+	c, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	})
+
+	// the prompt must be fetched again until part 2 is unlocked
+	for _, p := range []string{partOnePage, partTwoPage, partTwoPage} {
+		page = p
+		if _, err := c.Prompt(context.Background(), 2024, 1); err != nil {
+			t.Fatalf("failed to fetch prompt: %v", err)
+		}
+	}
+	if *requests != 2 {
+		t.Errorf("expected 2 requests to the server, got %d", *requests)
+	}
+
+	text, err := c.Prompt(context.Background(), 2024, 1)
+	if err != nil {
+		t.Fatalf("failed to fetch prompt: %v", err)
+	}
+	if want := "--- Day 1: Historian Hysteria ---\nPart one.\n\n--- Part Two ---\nPart two.\n"; text != want {
+		t.Errorf("got prompt text %q, want %q", text, want)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("1\n"))
+	})
+	c.MinInterval = 100 * time.Millisecond
+
+	start := time.Now()
+	for day := 1; day <= 3; day++ {
+		if _, err := c.Input(context.Background(), 2024, day); err != nil {
+			t.Fatalf("failed to fetch input: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*c.MinInterval {
+		t.Errorf("3 requests took %s, expected at least %s", elapsed, 2*c.MinInterval)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.Input(ctx, 2024, 4); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the rate limited request to be canceled, got %v", err)
+	}
+}
+
+func TestRateLimitAcrossClients(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("1\n"))
+	})
+	c.MinInterval = 200 * time.Millisecond
+	if _, err := c.Input(context.Background(), 2024, 1); err != nil {
+		t.Fatalf("failed to fetch input: %v", err)
+	}
+
+	// a new client with the same cache directory, e.g. in the next run of the command
+	next := NewClient(testSession, c.CacheDir)
+	next.BaseURL = c.BaseURL
+	next.MinInterval = c.MinInterval
+
+	start := time.Now()
+	if _, err := next.Input(context.Background(), 2024, 2); err != nil {
+		t.Fatalf("failed to fetch input: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < c.MinInterval/2 {
+		t.Errorf("the request right after another client's took %s, expected about %s", elapsed, c.MinInterval)
+	}
+}
@@ -0,0 +1,105 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Attempt is a submitted answer and the website's verdict on it.
+type Attempt struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// Ledger is a record of every submitted answer, kept in a JSON file, which is
+// used to avoid submitting answers which are already known to be wrong.
+type Ledger struct {
+	path     string
+	Attempts []Attempt
+}
+
+// LoadLedger loads the ledger in the given file, which is created on the first Record.
+func LoadLedger(path string) (*Ledger, error) {
+	l := &Ledger{path: path, Attempts: []Attempt{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ledger file: %v", err)
+	}
+	if err := json.Unmarshal(data, &l.Attempts); err != nil {
+		return nil, fmt.Errorf("failed to decode ledger file: %v", err)
+	}
+	return l, nil
+}
+
+// Record adds the given attempt to the ledger and saves it.
+func (l *Ledger) Record(a Attempt) error {
+	l.Attempts = append(l.Attempts, a)
+
+	data, err := json.MarshalIndent(l.Attempts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode ledger: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("failed to create ledger directory: %v", err)
+	}
+	if err := os.WriteFile(l.path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write ledger file: %v", err)
+	}
+	return nil
+}
+
+// Check checks a new answer to the given part of a puzzle against the previous attempts.
+// It returns an error when the answer must not be submitted, i.e. the part is already
+// solved or the same answer was submitted and was wrong, and a warning when a numeric
+// answer is out of the bounds set by previous answers which were too high or too low.
+func (l *Ledger) Check(year, day, part int, answer string) (warning string, err error) {
+	var low, high *big.Int // the highest answer which was too low and the lowest which was too high
+
+	for _, a := range l.Attempts {
+		if a.Year != year || a.Day != day || a.Part != part {
+			continue
+		}
+		if a.Verdict == Correct {
+			return "", fmt.Errorf("part %d is already solved, the answer was %s", part, a.Answer)
+		}
+		if a.Answer == answer && a.Verdict.IsWrong() {
+			return "", fmt.Errorf("%s was already submitted on %s and was %s", answer, a.Time.Format(time.DateTime), a.Verdict)
+		}
+
+		n, ok := new(big.Int).SetString(a.Answer, 10)
+		if !ok {
+			continue
+		}
+		if a.Verdict == TooLow && (low == nil || n.Cmp(low) > 0) {
+			low = n
+		}
+		if a.Verdict == TooHigh && (high == nil || n.Cmp(high) < 0) {
+			high = n
+		}
+	}
+
+	n, ok := new(big.Int).SetString(answer, 10)
+	if !ok {
+		return "", nil
+	}
+	if low != nil && n.Cmp(low) <= 0 {
+		return fmt.Sprintf("%s is not higher than %s, which was too low", answer, low), nil
+	}
+	if high != nil && n.Cmp(high) >= 0 {
+		return fmt.Sprintf("%s is not lower than %s, which was too high", answer, high), nil
+	}
+	return "", nil
+}
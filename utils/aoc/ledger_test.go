@@ -0,0 +1,65 @@
+package aoc
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLedger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "aoc", "ledger.json")
+
+	l, err := LoadLedger(path)
+	if err != nil {
+		t.Fatalf("failed to load ledger: %v", err)
+	}
+	for _, a := range []Attempt{
+		{Year: 2024, Day: 1, Part: 1, Answer: "100", Verdict: TooHigh},
+		{Year: 2024, Day: 1, Part: 1, Answer: "10", Verdict: TooLow},
+		{Year: 2024, Day: 1, Part: 1, Answer: "20", Verdict: TooSoon},
+		{Year: 2024, Day: 1, Part: 2, Answer: "31", Verdict: Correct},
+		{Year: 2024, Day: 2, Part: 2, Answer: "7", Verdict: WrongLevel},
+	} {
+		if err := l.Record(a); err != nil {
+			t.Fatalf("failed to record attempt: %v", err)
+		}
+	}
+
+	// the ledger must survive a reload
+	if l, err = LoadLedger(path); err != nil {
+		t.Fatalf("failed to reload ledger: %v", err)
+	}
+	if len(l.Attempts) != 5 {
+		t.Fatalf("expected 5 attempts in the reloaded ledger, got %d", len(l.Attempts))
+	}
+
+	tests := []struct {
+		day     int
+		part    int
+		answer  string
+		warning string
+		err     string
+	}{
+		{1, 1, "50", "", ""},
+		{1, 1, "20", "", ""},
+		{1, 1, "100", "", "already submitted"},
+		{1, 1, "10", "", "already submitted"},
+		{1, 1, "150", "which was too high", ""},
+		{1, 1, "5", "which was too low", ""},
+		{1, 2, "32", "", "already solved"},
+		// part 2 may have been submitted before it was unlocked, so it can be submitted again
+		{2, 2, "7", "", ""},
+	}
+	for _, test := range tests {
+		warning, err := l.Check(2024, test.day, test.part, test.answer)
+		if test.err == "" && err != nil {
+			t.Errorf("unexpected error for answer %s to part %d: %v", test.answer, test.part, err)
+		}
+		if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("expected an error containing \"%s\" for answer %s to part %d, got %v", test.err, test.answer, test.part, err)
+		}
+		if (test.warning == "") != (warning == "") || !strings.Contains(warning, test.warning) {
+			t.Errorf("got warning \"%s\" for answer %s to part %d, want one containing \"%s\"", warning, test.answer, test.part, test.warning)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package aoc
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// partTwoHeader is the header of the second part of a puzzle's prompt.
+const partTwoHeader = "--- Part Two ---"
+
+var (
+	articleRegex = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	tagRegex     = regexp.MustCompile(`<(/?)([a-zA-Z0-9]+)[^>]*>`)
+)
+
+// PromptText extracts the description of a puzzle (both parts, once the second is
+// unlocked) from the given puzzle page and converts it to plain text, laid out the
+// same way as the prompt.txt files in this repository.
+func PromptText(page string) (string, error) {
+	articles := articleRegex.FindAllStringSubmatch(page, -1)
+	if len(articles) == 0 {
+		return "", errors.New("no puzzle description found in page")
+	}
+
+	var sb strings.Builder
+	for _, article := range articles {
+		body := article[1]
+
+		prev, inPre := 0, false
+		for _, m := range tagRegex.FindAllStringSubmatchIndex(body, -1) {
+			writeText(&sb, body[prev:m[0]], inPre)
+			prev = m[1]
+
+			closing, tag := body[m[2]:m[3]] == "/", strings.ToLower(body[m[4]:m[5]])
+			if tag == "pre" {
+				inPre = !closing
+			}
+			if !closing {
+				continue
+			}
+			switch tag {
+			case "h2", "li", "ul":
+				sb.WriteString("\n")
+			case "p":
+				sb.WriteString("\n\n")
+			}
+		}
+		writeText(&sb, body[prev:], inPre)
+	}
+
+	return strings.TrimRight(sb.String(), "\n") + "\n", nil
+}
+
+// writeText writes the given text from between two tags, unless it is only a line break
+// between blocks, which is part of the layout of the page's HTML rather than its text.
+func writeText(sb *strings.Builder, text string, inPre bool) {
+	if !inPre && strings.TrimSpace(text) == "" && strings.Contains(text, "\n") {
+		return
+	}
+	sb.WriteString(html.UnescapeString(text))
+}
@@ -0,0 +1,107 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the website's verdict on a submitted answer.
+type Verdict string
+
+const (
+	Correct Verdict = "correct"
+	TooHigh Verdict = "too high"
+	TooLow  Verdict = "too low"
+	Wrong   Verdict = "wrong"
+	TooSoon Verdict = "too soon"
+	// WrongLevel is the verdict when the part can't be answered, which is either because it is
+	// already solved, or because it is locked (e.g. part 2 before part 1 is solved).
+	WrongLevel Verdict = "wrong level"
+)
+
+// IsWrong returns true for the verdicts on answers which are not the right answer.
+func (v Verdict) IsWrong() bool {
+	return v == TooHigh || v == TooLow || v == Wrong
+}
+
+// Result is the result of submitting an answer.
+type Result struct {
+	Verdict Verdict
+	// Wait is how long the website asks to wait before submitting another answer.
+	Wait time.Duration
+	// Message is the website's response in plain text.
+	Message string
+}
+
+var (
+	responseRegex = regexp.MustCompile(`(?s)<article>(.*?)</article>`)
+	anyTagRegex   = regexp.MustCompile(`<[^>]*>`)
+	waitRegex     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	waitMinRegex  = regexp.MustCompile(`(?i)wait (one|\d+) minutes? before trying again`)
+)
+
+// Submit submits the answer to the given part of the puzzle for the given year and day.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Result, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	page, err := c.do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to submit answer: %w", err)
+	}
+	return ParseResult(string(page))
+}
+
+// ParseResult parses the page the website responds to a submitted answer with.
+func ParseResult(page string) (Result, error) {
+	match := responseRegex.FindStringSubmatch(page)
+	if match == nil {
+		return Result{}, errors.New("no response found in page")
+	}
+	message := strings.Join(strings.Fields(html.UnescapeString(anyTagRegex.ReplaceAllString(match[1], ""))), " ")
+
+	result := Result{Message: message}
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		result.Verdict = Correct
+	case strings.Contains(message, "your answer is too high"):
+		result.Verdict = TooHigh
+	case strings.Contains(message, "your answer is too low"):
+		result.Verdict = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		result.Verdict = Wrong
+	case strings.Contains(message, "You gave an answer too recently"):
+		result.Verdict = TooSoon
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		result.Verdict = WrongLevel
+	default:
+		return Result{}, fmt.Errorf("unrecognized response \"%s\"", message)
+	}
+
+	if m := waitRegex.FindStringSubmatch(message); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := waitMinRegex.FindStringSubmatch(message); m != nil {
+		minutes, err := strconv.Atoi(m[1])
+		if err != nil {
+			minutes = 1 // "one minute"
+		}
+		result.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return result, nil
+}
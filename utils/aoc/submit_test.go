@@ -0,0 +1,81 @@
+package aoc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func responsePage(message string) string {
+	return "<html><body><main>\n<article><p>" + message + "</p></article>\n</main></body></html>"
+}
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		message string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{`That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the Chief Historian. <a href="/2024/day/1#part2">[Continue to Part Two]</a>`, Correct, 0},
+		{`That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2024/day/1">[Return to Day 1]</a>`, TooHigh, time.Minute},
+		{`That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.`, TooLow, 5 * time.Minute},
+		{`That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.`, Wrong, time.Minute},
+		{`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 23s left to wait. <a href="/2024/day/1">[Return to Day 1]</a>`, TooSoon, 83 * time.Second},
+		{`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait.`, TooSoon, 34 * time.Second},
+		{`You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/1">[Return to Day 1]</a>`, WrongLevel, 0},
+	}
+	for _, test := range tests {
+		result, err := ParseResult(responsePage(test.message))
+		if err != nil {
+			t.Errorf("failed to parse response \"%s\": %v", test.message, err)
+			continue
+		}
+		if result.Verdict != test.verdict || result.Wait != test.wait {
+			t.Errorf("got %s (wait %s) for \"%s\", want %s (wait %s)", result.Verdict, result.Wait, test.message, test.verdict, test.wait)
+		}
+	}
+
+	if _, err := ParseResult(responsePage("Something else entirely.")); err == nil {
+		t.Error("expected an error for an unrecognized response")
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	c, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/1/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.FormValue("level") != "2" {
+			w.Write([]byte(responsePage("You don't seem to be solving the right level.  Did you already complete it?")))
+			return
+		}
+		if r.FormValue("answer") != "31" {
+			w.Write([]byte(responsePage("That's not the right answer; your answer is too high.")))
+			return
+		}
+		w.Write([]byte(responsePage("That's the right answer!  You are one gold star closer.")))
+	})
+
+	for _, test := range []struct {
+		part    int
+		answer  string
+		verdict Verdict
+	}{
+		{2, "31", Correct},
+		{2, "32", TooHigh},
+		{1, "11", WrongLevel},
+	} {
+		result, err := c.Submit(context.Background(), 2024, 1, test.part, test.answer)
+		if err != nil {
+			t.Fatalf("failed to submit answer: %v", err)
+		}
+		if result.Verdict != test.verdict {
+			t.Errorf("got %s for answer %s to part %d, want %s", result.Verdict, test.answer, test.part, test.verdict)
+		}
+	}
+	if *requests != 3 {
+		t.Errorf("expected 3 requests to the server, got %d", *requests)
+	}
+}
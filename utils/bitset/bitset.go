@@ -0,0 +1,54 @@
+package bitset
+
+import "math/bits"
+
+// Bitset is a set of the integers from zero up to its size, stored as one bit each.
+type Bitset []uint64
+
+// New returns an empty bitset which can hold the integers from 0 to n-1.
+func New(n int) Bitset {
+	return make(Bitset, (n+63)/64)
+}
+
+// Has returns true when i is in the set.
+func (b Bitset) Has(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// Put adds i to the set.
+func (b Bitset) Put(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+// Remove removes i from the set.
+func (b Bitset) Remove(i int) {
+	b[i/64] &^= 1 << (i % 64)
+}
+
+// Visit adds i to the set, returning true when it already was in it.
+func (b Bitset) Visit(i int) bool {
+	had := b.Has(i)
+	b.Put(i)
+	return had
+}
+
+// Union adds every integer in other (which must be at most as large) to the set.
+func (b Bitset) Union(other Bitset) {
+	for i, word := range other {
+		b[i] |= word
+	}
+}
+
+// Size returns the number of integers in the set.
+func (b Bitset) Size() int {
+	n := 0
+	for _, word := range b {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// Clear removes every integer from the set.
+func (b Bitset) Clear() {
+	clear(b)
+}
@@ -0,0 +1,39 @@
+package bitset
+
+import "testing"
+
+func TestBitset(t *testing.T) {
+	b := New(130)
+	for _, i := range []int{0, 63, 64, 129} {
+		if b.Visit(i) {
+			t.Errorf("expected %d not to be in the set yet", i)
+		}
+		if !b.Visit(i) {
+			t.Errorf("expected %d to be in the set", i)
+		}
+	}
+	if b.Has(1) || b.Has(65) {
+		t.Error("expected only the added integers to be in the set")
+	}
+	if b.Size() != 4 {
+		t.Errorf("got size %d, want 4", b.Size())
+	}
+
+	other := New(130)
+	other.Put(1)
+	other.Put(64)
+	b.Union(other)
+	if b.Size() != 5 || !b.Has(1) {
+		t.Errorf("got size %d after the union, want 5 including 1", b.Size())
+	}
+
+	b.Remove(64)
+	if b.Has(64) || b.Size() != 4 {
+		t.Errorf("expected 64 to be removed, got size %d", b.Size())
+	}
+
+	b.Clear()
+	if b.Size() != 0 {
+		t.Errorf("got size %d after clearing, want 0", b.Size())
+	}
+}
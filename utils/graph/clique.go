@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+)
+
+// Triangles returns every triangle (i.e. clique of three nodes) in the graph,
+// each as the IDs of its nodes in increasing order, ordered by those IDs.
+func Triangles(g *Undirected) [][3]int {
+	triangles := [][3]int{}
+	for a := range g.adj {
+		for _, b := range g.adj[a] {
+			if b <= a {
+				continue
+			}
+			// the neighbors both a and b have after b, found by merging their sorted neighbors
+			na, nb := g.adj[a], g.adj[b]
+			i, _ := slices.BinarySearch(na, b+1)
+			j, _ := slices.BinarySearch(nb, b+1)
+			for i < len(na) && j < len(nb) {
+				switch {
+				case na[i] < nb[j]:
+					i++
+				case na[i] > nb[j]:
+					j++
+				default:
+					triangles = append(triangles, [3]int{a, b, na[i]})
+					i++
+					j++
+				}
+			}
+		}
+	}
+	return triangles
+}
+
+// Cores returns the k-core decomposition of the graph: the core number of every node (the
+// largest k for which it is in the k-core, i.e. the largest subgraph in which every node has
+// at least k neighbors) and a degeneracy ordering of the nodes (in which every node has at
+// most k neighbors after it, for the largest core number k). It runs in linear time with
+// the algorithm by Batagelj and Zaversnik.
+func Cores(g *Undirected) (cores []int, order []int) {
+	n := len(g.adj)
+	degree := make([]int, n)
+	maxDegree := 0
+	for v := range g.adj {
+		degree[v] = len(g.adj[v])
+		maxDegree = max(maxDegree, degree[v])
+	}
+
+	// bucket sort the nodes by degree, where bin[d] is the start of the nodes of degree d in
+	// order, and pos[v] the position of node v, such that a node can move to the previous
+	// bucket in constant time when a neighbor is removed before it
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+	start := 0
+	for d, count := range bin {
+		bin[d] = start
+		start += count
+	}
+	order = make([]int, n)
+	pos := make([]int, n)
+	for v, d := range degree {
+		pos[v] = bin[d]
+		order[pos[v]] = v
+		bin[d]++
+	}
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	for i := 0; i < n; i++ {
+		v := order[i]
+		for _, u := range g.adj[v] {
+			if degree[u] <= degree[v] {
+				continue
+			}
+			// swap u with the first node of its bucket, then shrink that bucket past it
+			du, pu := degree[u], pos[u]
+			pw := bin[du]
+			if w := order[pw]; u != w {
+				order[pu], order[pw] = w, u
+				pos[u], pos[w] = pw, pu
+			}
+			bin[du]++
+			degree[u]--
+		}
+	}
+	return degree, order
+}
+
+// KCore returns the sorted IDs of the nodes in the k-core of the graph, i.e.
+// the largest subgraph in which every node has at least k neighbors.
+func KCore(g *Undirected, k int) []int {
+	cores, _ := Cores(g)
+	nodes := []int{}
+	for v, core := range cores {
+		if core >= k {
+			nodes = append(nodes, v)
+		}
+	}
+	return nodes
+}
+
+// MaximalCliques returns an iterator over every maximal clique in the graph (i.e. every
+// clique which is not part of a larger one), each as the sorted IDs of its nodes. It
+// uses the Bron-Kerbosch algorithm with pivoting, starting from each node in degeneracy
+// order, which keeps the sets of candidate nodes small even for large, sparse networks.
+func MaximalCliques(g *Undirected) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		_, order := Cores(g)
+		rank := make([]int, len(order))
+		for i, v := range order {
+			rank[v] = i
+		}
+
+		for _, v := range order {
+			candidates, excluded := []int{}, []int{}
+			for _, u := range g.adj[v] {
+				if rank[u] > rank[v] {
+					candidates = append(candidates, u)
+				} else {
+					excluded = append(excluded, u)
+				}
+			}
+			if !bronKerbosch(g, []int{v}, candidates, excluded, yield) {
+				return
+			}
+		}
+	}
+}
+
+// bronKerbosch reports every maximal clique which extends the given clique with some
+// of the candidate nodes and none of the excluded ones. It returns false once yield does.
+func bronKerbosch(g *Undirected, clique, candidates, excluded []int, yield func([]int) bool) bool {
+	if len(candidates) == 0 {
+		if len(excluded) > 0 {
+			return true // not maximal, the clique could be extended with an excluded node
+		}
+		found := slices.Clone(clique)
+		slices.Sort(found)
+		return yield(found)
+	}
+
+	// every maximal clique contains either the pivot or one of its non-neighbors, so only
+	// those need to be tried, and the pivot with the most neighbors leaves the fewest
+	pivot, most := -1, -1
+	for _, nodes := range [][]int{candidates, excluded} {
+		for _, u := range nodes {
+			if n := countNeighbors(g, u, candidates); n > most {
+				pivot, most = u, n
+			}
+		}
+	}
+
+	for _, v := range slices.Clone(candidates) {
+		if g.HasEdge(pivot, v) {
+			continue
+		}
+		if !bronKerbosch(g, append(clique, v), neighborsIn(g, v, candidates), neighborsIn(g, v, excluded), yield) {
+			return false
+		}
+		candidates = slices.DeleteFunc(candidates, func(u int) bool { return u == v })
+		excluded = append(excluded, v)
+	}
+	return true
+}
+
+// MaximumClique returns a largest clique in the graph, as the sorted IDs of its nodes.
+func MaximumClique(g *Undirected) []int {
+	largest := []int{}
+	for clique := range MaximalCliques(g) {
+		if len(clique) > len(largest) {
+			largest = clique
+		}
+	}
+	return largest
+}
+
+// ConnectedComponents returns the connected components of the graph, each as the sorted
+// IDs of its nodes, ordered by their lowest ID. Nodes without edges are components of one.
+func ConnectedComponents(g *Undirected) [][]int {
+	component := make([]int, len(g.adj))
+	for v := range component {
+		component[v] = -1
+	}
+
+	components := [][]int{}
+	for v := range g.adj {
+		if component[v] != -1 {
+			continue
+		}
+		id := len(components)
+		component[v] = id
+		members := []int{v}
+		for i := 0; i < len(members); i++ {
+			for _, u := range g.adj[members[i]] {
+				if component[u] == -1 {
+					component[u] = id
+					members = append(members, u)
+				}
+			}
+		}
+		slices.Sort(members)
+		components = append(components, members)
+	}
+	return components
+}
+
+func countNeighbors(g *Undirected, v int, nodes []int) int {
+	count := 0
+	for _, u := range nodes {
+		if g.HasEdge(v, u) {
+			count++
+		}
+	}
+	return count
+}
+
+func neighborsIn(g *Undirected, v int, nodes []int) []int {
+	neighbors := []int{}
+	for _, u := range nodes {
+		if g.HasEdge(v, u) {
+			neighbors = append(neighbors, u)
+		}
+	}
+	return neighbors
+}
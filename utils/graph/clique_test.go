@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// testNetwork is the sample network of 2024 day 23.
+const testNetwork = `kh-tc qp-kh de-cg ka-co yn-aq qp-ub cg-tb vc-aq tb-ka wh-tc yn-cg kh-ub ta-co de-co tc-td tb-wq
+wh-td ta-ka td-qp aq-cg wq-ub ub-vc de-ta wq-aq wq-vc wh-yn ka-de kh-ta co-tc wh-qp tb-vc td-yn`
+
+func networkGraph(t *testing.T, network string) (*Undirected, *Interner[string]) {
+	t.Helper()
+
+	names := NewInterner[string]()
+	g := NewUndirected(0)
+	for _, edge := range strings.Fields(network) {
+		a, b, ok := strings.Cut(edge, "-")
+		if !ok {
+			t.Fatalf("invalid edge %s", edge)
+		}
+		g.AddEdge(names.ID(a), names.ID(b))
+	}
+	return g, names
+}
+
+func names(in *Interner[string], ids []int) string {
+	keys := in.Keys(ids)
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
+
+func TestTriangles(t *testing.T) {
+	g, in := networkGraph(t, testNetwork)
+
+	triangles := Triangles(g)
+	if len(triangles) != 12 {
+		t.Errorf("got %d triangles, want 12", len(triangles))
+	}
+	found := map[string]bool{}
+	for _, triangle := range triangles {
+		if !g.HasEdge(triangle[0], triangle[1]) || !g.HasEdge(triangle[1], triangle[2]) || !g.HasEdge(triangle[0], triangle[2]) {
+			t.Errorf("%v is not a triangle", triangle)
+		}
+		found[names(in, triangle[:])] = true
+	}
+	for _, want := range []string{"aq,cg,yn", "co,de,ka", "co,ka,ta"} {
+		if !found[want] {
+			t.Errorf("expected to find triangle %s", want)
+		}
+	}
+	if found["ka,tb,wq"] {
+		t.Error("found ka,tb,wq, which is not a triangle")
+	}
+}
+
+func TestCliques(t *testing.T) {
+	g, in := networkGraph(t, testNetwork)
+
+	if got := names(in, MaximumClique(g)); got != "co,de,ka,ta" {
+		t.Errorf("got maximum clique %s, want co,de,ka,ta", got)
+	}
+
+	// every maximal clique must be a clique which cannot be extended, and be reported once
+	seen := map[string]bool{}
+	for clique := range MaximalCliques(g) {
+		key := fmt.Sprint(clique)
+		if seen[key] {
+			t.Errorf("clique %s reported twice", names(in, clique))
+		}
+		seen[key] = true
+
+		for i, a := range clique {
+			for _, b := range clique[i+1:] {
+				if !g.HasEdge(a, b) {
+					t.Errorf("%s is not a clique", names(in, clique))
+				}
+			}
+		}
+		for v := 0; v < g.Len(); v++ {
+			if !slices.Contains(clique, v) && countNeighbors(g, v, clique) == len(clique) {
+				t.Errorf("clique %s is not maximal, it could include %s", names(in, clique), in.Key(v))
+			}
+		}
+	}
+}
+
+func TestCores(t *testing.T) {
+	// a 4-clique (0-3) with a tail (3-4-5) and an isolated node 6
+	g := NewUndirected(7)
+	for _, edge := range [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 5}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+
+	cores, order := Cores(g)
+	if want := []int{3, 3, 3, 3, 1, 1, 0}; !slices.Equal(cores, want) {
+		t.Errorf("got core numbers %v, want %v", cores, want)
+	}
+
+	// in a degeneracy ordering, no node has more neighbors after it than the degeneracy (3)
+	rank := make([]int, len(order))
+	for i, v := range order {
+		rank[v] = i
+	}
+	for v := range order {
+		later := 0
+		for _, u := range g.NeighborIDs(v) {
+			if rank[u] > rank[v] {
+				later++
+			}
+		}
+		if later > 3 {
+			t.Errorf("node %d has %d neighbors after it in order %v", v, later, order)
+		}
+	}
+
+	if got := KCore(g, 2); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("got 2-core %v, want [0 1 2 3]", got)
+	}
+	if got := ConnectedComponents(g); fmt.Sprint(got) != "[[0 1 2 3 4 5] [6]]" {
+		t.Errorf("got components %v, want [[0 1 2 3 4 5] [6]]", got)
+	}
+}
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+// Graph is a directed graph with weighted edges between nodes of type N.
+type Graph[N comparable] interface {
+	// Neighbors returns an iterator over the nodes which can be reached
+	// from the given node in a single step, and the weights of those steps.
+	Neighbors(n N) iter.Seq2[N, int]
+}
+
+// Adjacency is a graph backed by adjacency maps, i.e. the weight of the edge from a to b is adj[a][b].
+type Adjacency[N comparable] map[N]map[N]int
+
+// NewAdjacency returns an empty graph backed by adjacency maps.
+func NewAdjacency[N comparable]() Adjacency[N] {
+	return make(Adjacency[N])
+}
+
+// AddEdge adds an edge from a to b with the given weight.
+func (adj Adjacency[N]) AddEdge(a, b N, weight int) {
+	if adj[a] == nil {
+		adj[a] = make(map[N]int)
+	}
+	if adj[b] == nil {
+		adj[b] = make(map[N]int)
+	}
+	adj[a][b] = weight
+}
+
+// AddUndirectedEdge adds edges both from a to b and from b to a with the given weight.
+func (adj Adjacency[N]) AddUndirectedEdge(a, b N, weight int) {
+	adj.AddEdge(a, b, weight)
+	adj.AddEdge(b, a, weight)
+}
+
+// Neighbors returns an iterator over the nodes with an edge from the given node.
+func (adj Adjacency[N]) Neighbors(n N) iter.Seq2[N, int] {
+	return func(yield func(N, int) bool) {
+		for neighbor, weight := range adj[n] {
+			if !yield(neighbor, weight) {
+				return
+			}
+		}
+	}
+}
+
+// Grid is a graph of the coordinates of a grid, with an edge of weight 1 from every
+// coordinate to each of its orthogonal neighbors which can be passed to from it.
+type Grid[T any] struct {
+	Grid grid.Grid[T]
+	// Passable returns true when a step can be taken between the given neighbors.
+	Passable func(from, to grid.Coordinate) bool
+}
+
+// FromGrid returns a graph of the coordinates of the given grid, where steps
+// between neighbors are allowed by the given passability predicate.
+func FromGrid[T any](g grid.Grid[T], passable func(from, to grid.Coordinate) bool) *Grid[T] {
+	return &Grid[T]{Grid: g, Passable: passable}
+}
+
+// Neighbors returns an iterator over the orthogonal neighbors of the given
+// coordinate which are within the grid and can be passed to from it.
+func (g *Grid[T]) Neighbors(c grid.Coordinate) iter.Seq2[grid.Coordinate, int] {
+	return func(yield func(grid.Coordinate, int) bool) {
+		for n := range g.Grid.Neighbors4(c) {
+			if !g.Passable(c, n) {
+				continue
+			}
+			if !yield(n, 1) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package graph
+
+// Interner assigns dense integer IDs (0, 1, 2, ...) to keys, e.g. the names of the
+// nodes of a network, such that graph algorithms can work with integers instead.
+type Interner[K comparable] struct {
+	ids  map[K]int
+	keys []K
+}
+
+// NewInterner returns an interner without any keys.
+func NewInterner[K comparable]() *Interner[K] {
+	return &Interner[K]{ids: make(map[K]int)}
+}
+
+// ID returns the ID of the given key, assigning it the next ID if it has none yet.
+func (in *Interner[K]) ID(key K) int {
+	if id, ok := in.ids[key]; ok {
+		return id
+	}
+	id := len(in.keys)
+	in.ids[key] = id
+	in.keys = append(in.keys, key)
+	return id
+}
+
+// Lookup returns the ID of the given key, or false when it has none.
+func (in *Interner[K]) Lookup(key K) (int, bool) {
+	id, ok := in.ids[key]
+	return id, ok
+}
+
+// Key returns the key with the given ID.
+func (in *Interner[K]) Key(id int) K {
+	return in.keys[id]
+}
+
+// Keys returns the keys with the given IDs.
+func (in *Interner[K]) Keys(ids []int) []K {
+	keys := make([]K, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, in.keys[id])
+	}
+	return keys
+}
+
+// Len returns the number of keys with an ID.
+func (in *Interner[K]) Len() int {
+	return len(in.keys)
+}
@@ -0,0 +1,214 @@
+package graph
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+// Paths are the shortest paths from a set of start nodes to every node reached by a search.
+type Paths[N comparable] struct {
+	// Dist is the length of the shortest path to each reached node.
+	Dist map[N]int
+	// prev holds every predecessor of each reached node on one of its shortest paths.
+	prev map[N][]N
+}
+
+func newPaths[N comparable]() *Paths[N] {
+	return &Paths[N]{Dist: make(map[N]int), prev: make(map[N][]N)}
+}
+
+// Distance returns the length of the shortest path to the given node, or false when it was not reached.
+func (p *Paths[N]) Distance(to N) (int, bool) {
+	d, ok := p.Dist[to]
+	return d, ok
+}
+
+// Path returns one of the shortest paths to the given node (from start
+// to end, inclusive), or false when the node was not reached.
+func (p *Paths[N]) Path(to N) ([]N, bool) {
+	if _, ok := p.Dist[to]; !ok {
+		return nil, false
+	}
+	path := []N{to}
+	for prev := p.prev[to]; len(prev) > 0; prev = p.prev[prev[0]] {
+		path = append(path, prev[0])
+	}
+	slices.Reverse(path)
+	return path, true
+}
+
+// AllPaths returns every shortest path to the given node. Note that there can be
+// exponentially many of them, see CountPaths for when only their number matters.
+func (p *Paths[N]) AllPaths(to N) [][]N {
+	if _, ok := p.Dist[to]; !ok {
+		return nil
+	}
+	if len(p.prev[to]) == 0 {
+		return [][]N{{to}}
+	}
+
+	paths := [][]N{}
+	for _, prev := range p.prev[to] {
+		for _, path := range p.AllPaths(prev) {
+			paths = append(paths, append(path, to))
+		}
+	}
+	return paths
+}
+
+// CountPaths returns the number of shortest paths to the given node.
+func (p *Paths[N]) CountPaths(to N) int {
+	return p.countPaths(to, make(map[N]int))
+}
+
+func (p *Paths[N]) countPaths(to N, memo map[N]int) int {
+	if _, ok := p.Dist[to]; !ok {
+		return 0
+	}
+	if len(p.prev[to]) == 0 {
+		return 1
+	}
+	if count, ok := memo[to]; ok {
+		return count
+	}
+	count := 0
+	for _, prev := range p.prev[to] {
+		count += p.countPaths(prev, memo)
+	}
+	memo[to] = count
+	return count
+}
+
+// relax records the path to the given node through prev with the given
+// distance, returning true when it is shorter than any path found before.
+func (p *Paths[N]) relax(prev, to N, dist int) bool {
+	known, ok := p.Dist[to]
+	switch {
+	case !ok || dist < known:
+		p.Dist[to] = dist
+		p.prev[to] = []N{prev}
+		return true
+	case dist == known:
+		p.prev[to] = append(p.prev[to], prev)
+	}
+	return false
+}
+
+// BFS finds the shortest paths (in number of steps, ignoring
+// edge weights) from the given start nodes to every reachable node.
+func BFS[N comparable](g Graph[N], starts ...N) *Paths[N] {
+	paths := newPaths[N]()
+	queue := []N{}
+	for _, start := range starts {
+		if _, ok := paths.Dist[start]; !ok {
+			paths.Dist[start] = 0
+			queue = append(queue, start)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for next := range g.Neighbors(current) {
+			if paths.relax(current, next, paths.Dist[current]+1) {
+				queue = append(queue, next)
+			}
+		}
+	}
+	return paths
+}
+
+// Dijkstra finds the shortest (i.e. lowest total weight) paths from the given
+// start nodes to every reachable node. Edge weights must be positive.
+func Dijkstra[N comparable](g Graph[N], starts ...N) *Paths[N] {
+	paths := newPaths[N]()
+	pq := &queue[N]{}
+	for _, start := range starts {
+		if _, ok := paths.Dist[start]; !ok {
+			paths.Dist[start] = 0
+			heap.Push(pq, item[N]{node: start})
+		}
+	}
+
+	for pq.Len() > 0 {
+		current := heap.Pop(pq).(item[N])
+		if current.priority > paths.Dist[current.node] {
+			continue // stale entry for a node which was reached by a shorter path since
+		}
+		for next, weight := range g.Neighbors(current.node) {
+			dist := current.priority + weight
+			if paths.relax(current.node, next, dist) {
+				heap.Push(pq, item[N]{node: next, priority: dist})
+			}
+		}
+	}
+	return paths
+}
+
+// Heuristic estimates the cost of the cheapest path from a node to the goal of a search.
+// To find the shortest path, it must never overestimate that cost (i.e. be admissible).
+type Heuristic[N comparable] func(n N) int
+
+// Zero is the heuristic which always estimates zero, with which A* is Dijkstra.
+func Zero[N comparable](N) int {
+	return 0
+}
+
+// ManhattanTo returns a heuristic which estimates the manhattan distance to
+// the given coordinate, for graphs of grids with orthogonal steps of weight 1.
+func ManhattanTo(goal grid.Coordinate) Heuristic[grid.Coordinate] {
+	return func(c grid.Coordinate) int {
+		return c.Manhattan(goal)
+	}
+}
+
+// AStar finds a shortest path from the start node to the nearest node which satisfies
+// the goal, guided by the given heuristic. It returns the path (from start to goal,
+// inclusive) and its length, or false when no goal node can be reached.
+func AStar[N comparable](g Graph[N], start N, goal func(N) bool, h Heuristic[N]) ([]N, int, bool) {
+	paths := newPaths[N]()
+	paths.Dist[start] = 0
+	pq := &queue[N]{}
+	heap.Push(pq, item[N]{node: start, priority: h(start)})
+
+	for pq.Len() > 0 {
+		current := heap.Pop(pq).(item[N])
+		dist := paths.Dist[current.node]
+		if current.priority > dist+h(current.node) {
+			continue // stale entry for a node which was reached by a shorter path since
+		}
+		if goal(current.node) {
+			path, _ := paths.Path(current.node)
+			return path, dist, true
+		}
+		for next, weight := range g.Neighbors(current.node) {
+			if paths.relax(current.node, next, dist+weight) {
+				heap.Push(pq, item[N]{node: next, priority: dist + weight + h(next)})
+			}
+		}
+	}
+	return nil, 0, false
+}
+
+// item is a node in a priority queue.
+type item[N comparable] struct {
+	node     N
+	priority int
+}
+
+// queue is a min-heap of nodes by priority, for use with container/heap.
+type queue[N comparable] []item[N]
+
+func (q queue[N]) Len() int           { return len(q) }
+func (q queue[N]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[N]) Push(x any)        { *q = append(*q, x.(item[N])) }
+func (q *queue[N]) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
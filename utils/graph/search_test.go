@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+const testMaze = `S..#....
+.#.#.##.
+.#...#..
+.####.#.
+......#E`
+
+func mazeGraph(t *testing.T) (*Grid[byte], grid.Coordinate, grid.Coordinate) {
+	t.Helper()
+
+	g, err := grid.ReadByte(strings.NewReader(testMaze))
+	if err != nil {
+		t.Fatalf("failed to read maze: %v", err)
+	}
+	var start, end grid.Coordinate
+	for c, v := range g.All() {
+		switch v {
+		case 'S':
+			start = c
+		case 'E':
+			end = c
+		}
+	}
+	return FromGrid(g, func(from, to grid.Coordinate) bool { return g[to.Y][to.X] != '#' }), start, end
+}
+
+func TestBFS(t *testing.T) {
+	g, start, end := mazeGraph(t)
+	paths := BFS(g, start)
+
+	if d, ok := paths.Distance(end); !ok || d != 15 {
+		t.Errorf("got distance %d (%t) to the end, want 15", d, ok)
+	}
+	if _, ok := paths.Distance(grid.Coordinate{X: 3, Y: 0}); ok {
+		t.Error("expected a wall not to be reached")
+	}
+
+	path, ok := paths.Path(end)
+	if !ok || len(path) != 16 || path[0] != start || path[len(path)-1] != end {
+		t.Fatalf("got path %v (%t), want 16 coordinates from start to end", path, ok)
+	}
+	for i := 1; i < len(path); i++ {
+		if path[i].Manhattan(path[i-1]) != 1 {
+			t.Errorf("path steps from %v to %v", &path[i-1], &path[i])
+		}
+	}
+
+	if paths.CountPaths(end) != len(paths.AllPaths(end)) {
+		t.Errorf("counted %d paths to the end, enumerated %d", paths.CountPaths(end), len(paths.AllPaths(end)))
+	}
+
+	// there are 6 shortest paths between opposite corners of an open 3x3 grid
+	open := grid.Grid[byte]{[]byte("..."), []byte("..."), []byte("...")}
+	paths = BFS(FromGrid(open, func(from, to grid.Coordinate) bool { return true }), grid.Coordinate{})
+	corner := grid.Coordinate{X: 2, Y: 2}
+	if all := paths.AllPaths(corner); len(all) != 6 || paths.CountPaths(corner) != 6 {
+		t.Errorf("got %d paths (counted %d) across an open grid, want 6", len(all), paths.CountPaths(corner))
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	g := NewAdjacency[string]()
+	g.AddEdge("a", "b", 7)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("c", "b", 3)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("c", "d", 9)
+	g.AddUndirectedEdge("d", "e", 4)
+
+	paths := Dijkstra(g, "a")
+	for node, want := range map[string]int{"a": 0, "b": 5, "c": 2, "d": 6, "e": 10} {
+		if d, ok := paths.Distance(node); !ok || d != want {
+			t.Errorf("got distance %d (%t) to %s, want %d", d, ok, node, want)
+		}
+	}
+	if path, _ := paths.Path("e"); strings.Join(path, "") != "acbde" {
+		t.Errorf("got path %v to e, want a c b d e", path)
+	}
+	if _, ok := Dijkstra(g, "e").Distance("a"); ok {
+		t.Error("expected a not to be reachable from e")
+	}
+
+	// a start given twice must not count its paths twice
+	if got, want := Dijkstra(g, "a", "a").CountPaths("e"), paths.CountPaths("e"); got != want {
+		t.Errorf("got %d paths to e with a duplicate start, want %d", got, want)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	g, start, end := mazeGraph(t)
+	isEnd := func(c grid.Coordinate) bool { return c == end }
+
+	for name, h := range map[string]Heuristic[grid.Coordinate]{"zero": Zero[grid.Coordinate], "manhattan": ManhattanTo(end)} {
+		path, cost, ok := AStar(g, start, isEnd, h)
+		if !ok || cost != 15 || len(path) != 16 {
+			t.Errorf("%s: got a path of %d coordinates costing %d (%t), want 16 costing 15", name, len(path), cost, ok)
+		}
+	}
+
+	wall := func(c grid.Coordinate) bool { return c == grid.Coordinate{X: 3, Y: 0} }
+	if _, _, ok := AStar(g, start, wall, Zero[grid.Coordinate]); ok {
+		t.Error("expected no path to a wall")
+	}
+}
@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+)
+
+// Undirected is an undirected graph without weights (i.e. every edge has weight 1)
+// between nodes with dense integer IDs from 0 up to its length, see Interner.
+type Undirected struct {
+	// adj holds the sorted IDs of the neighbors of every node
+	adj [][]int
+}
+
+// NewUndirected returns a graph of n nodes without any edges.
+func NewUndirected(n int) *Undirected {
+	return &Undirected{adj: make([][]int, n)}
+}
+
+// Len returns the number of nodes in the graph.
+func (g *Undirected) Len() int {
+	return len(g.adj)
+}
+
+// AddEdge adds an edge between a and b, growing the graph to include both.
+func (g *Undirected) AddEdge(a, b int) {
+	for len(g.adj) <= max(a, b) {
+		g.adj = append(g.adj, nil)
+	}
+	g.adj[a] = insertSorted(g.adj[a], b)
+	g.adj[b] = insertSorted(g.adj[b], a)
+}
+
+// HasEdge returns true when there is an edge between a and b.
+func (g *Undirected) HasEdge(a, b int) bool {
+	if a < 0 || a >= len(g.adj) {
+		return false
+	}
+	_, found := slices.BinarySearch(g.adj[a], b)
+	return found
+}
+
+// Degree returns the number of neighbors of the given node.
+func (g *Undirected) Degree(n int) int {
+	return len(g.adj[n])
+}
+
+// NeighborIDs returns the sorted IDs of the neighbors of the given node, which must not be modified.
+func (g *Undirected) NeighborIDs(n int) []int {
+	return g.adj[n]
+}
+
+// Neighbors returns an iterator over the neighbors of the given node, such that
+// the graph can be searched with BFS, Dijkstra and AStar.
+func (g *Undirected) Neighbors(n int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for _, neighbor := range g.adj[n] {
+			if !yield(neighbor, 1) {
+				return
+			}
+		}
+	}
+}
+
+func insertSorted(s []int, v int) []int {
+	i, found := slices.BinarySearch(s, v)
+	if found {
+		return s
+	}
+	return slices.Insert(s, i, v)
+}
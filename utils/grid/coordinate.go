@@ -7,10 +7,57 @@ type Coordinate struct {
 	Y int
 }
 
-func (c *Coordinate) String() string {
+func (c Coordinate) String() string {
 	return fmt.Sprintf("(%d,%d)", c.Y, c.X)
 }
 
 func (c *Coordinate) Equal(c2 *Coordinate) bool {
 	return c.X == c2.X && c.Y == c2.Y
 }
+
+// Add returns the sum of the coordinates, i.e. the coordinate moved by the given offset.
+func (c Coordinate) Add(c2 Coordinate) Coordinate {
+	return Coordinate{X: c.X + c2.X, Y: c.Y + c2.Y}
+}
+
+// Sub returns the difference of the coordinates, i.e. the offset from c2 to c.
+func (c Coordinate) Sub(c2 Coordinate) Coordinate {
+	return Coordinate{X: c.X - c2.X, Y: c.Y - c2.Y}
+}
+
+// Scale returns the coordinate multiplied by k.
+func (c Coordinate) Scale(k int) Coordinate {
+	return Coordinate{X: c.X * k, Y: c.Y * k}
+}
+
+// Move returns the coordinate moved one step in the given direction.
+func (c Coordinate) Move(d Direction) Coordinate {
+	return c.Add(d.Offset())
+}
+
+// Manhattan returns the manhattan (i.e. taxicab) distance between the coordinates.
+func (c Coordinate) Manhattan(c2 Coordinate) int {
+	return abs(c.X-c2.X) + abs(c.Y-c2.Y)
+}
+
+// Chebyshev returns the chebyshev (i.e. chessboard) distance between the coordinates.
+func (c Coordinate) Chebyshev(c2 Coordinate) int {
+	return max(abs(c.X-c2.X), abs(c.Y-c2.Y))
+}
+
+// Mod returns the coordinate wrapped around a space of the given dimensions
+// (width as X, height as Y), such that both of its values are non-negative.
+func (c Coordinate) Mod(dims Coordinate) Coordinate {
+	return Coordinate{X: mod(c.X, dims.X), Y: mod(c.Y, dims.Y)}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func mod(n, m int) int {
+	return ((n % m) + m) % m
+}
@@ -0,0 +1,97 @@
+package grid
+
+import "fmt"
+
+// Direction is a compass direction on a grid, where north is up (i.e. decreasing Y).
+type Direction int
+
+const (
+	North Direction = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+var (
+	// Directions4 are the orthogonal directions, clockwise from north.
+	Directions4 = []Direction{North, East, South, West}
+	// Directions8 are the orthogonal and diagonal directions, clockwise from north.
+	Directions8 = []Direction{North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest}
+
+	directionOffsets = [...]Coordinate{
+		North:     {X: 0, Y: -1},
+		NorthEast: {X: 1, Y: -1},
+		East:      {X: 1, Y: 0},
+		SouthEast: {X: 1, Y: 1},
+		South:     {X: 0, Y: 1},
+		SouthWest: {X: -1, Y: 1},
+		West:      {X: -1, Y: 0},
+		NorthWest: {X: -1, Y: -1},
+	}
+	directionNames = [...]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+)
+
+// ParseDirection parses an orthogonal direction from an arrow (^v<>),
+// compass (NSEW) or up/down/left/right (UDLR) character.
+func ParseDirection(char byte) (Direction, error) {
+	switch char {
+	case '^', 'N', 'U':
+		return North, nil
+	case 'v', 'S', 'D':
+		return South, nil
+	case '>', 'E', 'R':
+		return East, nil
+	case '<', 'W', 'L':
+		return West, nil
+	default:
+		return 0, fmt.Errorf("invalid direction character '%c'", char)
+	}
+}
+
+// Offset returns the offset of a single step in the direction.
+func (d Direction) Offset() Coordinate {
+	return directionOffsets[d]
+}
+
+// TurnRight returns the direction 90 degrees clockwise.
+func (d Direction) TurnRight() Direction {
+	return (d + 2) % 8
+}
+
+// TurnLeft returns the direction 90 degrees counterclockwise.
+func (d Direction) TurnLeft() Direction {
+	return (d + 6) % 8
+}
+
+// Opposite returns the direction 180 degrees around.
+func (d Direction) Opposite() Direction {
+	return (d + 4) % 8
+}
+
+// Arrow returns the arrow character (^v<>) of an orthogonal direction, or '?' for any other direction.
+func (d Direction) Arrow() byte {
+	switch d {
+	case North:
+		return '^'
+	case South:
+		return 'v'
+	case East:
+		return '>'
+	case West:
+		return '<'
+	default:
+		return '?'
+	}
+}
+
+// String returns the compass name of the direction (e.g. "NE"), or "Direction(n)" when it is not one.
+func (d Direction) String() string {
+	if d < 0 || int(d) >= len(directionNames) {
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+	return directionNames[d]
+}
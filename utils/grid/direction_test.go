@@ -0,0 +1,82 @@
+package grid
+
+import "testing"
+
+func TestCoordinateArithmetic(t *testing.T) {
+	a, b := Coordinate{X: 2, Y: -3}, Coordinate{X: -1, Y: 4}
+
+	if got, want := a.Add(b), (Coordinate{X: 1, Y: 1}); got != want {
+		t.Errorf("Add: got %v, want %v", &got, &want)
+	}
+	if got, want := a.Sub(b), (Coordinate{X: 3, Y: -7}); got != want {
+		t.Errorf("Sub: got %v, want %v", &got, &want)
+	}
+	if got, want := a.Scale(-2), (Coordinate{X: -4, Y: 6}); got != want {
+		t.Errorf("Scale: got %v, want %v", &got, &want)
+	}
+	if got := a.Manhattan(b); got != 10 {
+		t.Errorf("Manhattan: got %d, want 10", got)
+	}
+	if got := a.Chebyshev(b); got != 7 {
+		t.Errorf("Chebyshev: got %d, want 7", got)
+	}
+
+	dims := Coordinate{X: 11, Y: 7}
+	for _, test := range []struct{ c, want Coordinate }{
+		{Coordinate{X: 3, Y: 4}, Coordinate{X: 3, Y: 4}},
+		{Coordinate{X: 12, Y: 14}, Coordinate{X: 1, Y: 0}},
+		{Coordinate{X: -1, Y: -15}, Coordinate{X: 10, Y: 6}},
+	} {
+		if got := test.c.Mod(dims); got != test.want {
+			t.Errorf("Mod: got %v for %v, want %v", &got, &test.c, &test.want)
+		}
+	}
+}
+
+func TestDirections(t *testing.T) {
+	for _, test := range []struct {
+		d, right, left, opposite Direction
+		offset                   Coordinate
+	}{
+		{North, East, West, South, Coordinate{X: 0, Y: -1}},
+		{East, South, North, West, Coordinate{X: 1, Y: 0}},
+		{SouthWest, NorthWest, SouthEast, NorthEast, Coordinate{X: -1, Y: 1}},
+	} {
+		if got := test.d.TurnRight(); got != test.right {
+			t.Errorf("%s turned right: got %s, want %s", test.d, got, test.right)
+		}
+		if got := test.d.TurnLeft(); got != test.left {
+			t.Errorf("%s turned left: got %s, want %s", test.d, got, test.left)
+		}
+		if got := test.d.Opposite(); got != test.opposite {
+			t.Errorf("opposite of %s: got %s, want %s", test.d, got, test.opposite)
+		}
+		if got := (Coordinate{X: 5, Y: 5}).Move(test.d).Sub(Coordinate{X: 5, Y: 5}); got != test.offset {
+			t.Errorf("%s moved by %v, want %v", test.d, &got, &test.offset)
+		}
+	}
+
+	for chars, want := range map[string]Direction{"^NU": North, "vSD": South, ">ER": East, "<WL": West} {
+		for _, char := range []byte(chars) {
+			d, err := ParseDirection(char)
+			if err != nil || d != want {
+				t.Errorf("parsed '%c' as %s (%v), want %s", char, d, err, want)
+			}
+		}
+		if got := want.Arrow(); got != chars[0] {
+			t.Errorf("arrow of %s: got '%c', want '%c'", want, got, chars[0])
+		}
+	}
+	if _, err := ParseDirection('x'); err == nil {
+		t.Error("expected an error for an invalid direction character")
+	}
+	if got := NorthEast.Arrow(); got != '?' {
+		t.Errorf("arrow of %s: got '%c', want '?'", NorthEast, got)
+	}
+	if got := Direction(8).Arrow(); got != '?' {
+		t.Errorf("arrow of an invalid direction: got '%c', want '?'", got)
+	}
+	if got := Direction(-1).String(); got != "Direction(-1)" {
+		t.Errorf("got name %q for an invalid direction, want \"Direction(-1)\"", got)
+	}
+}
@@ -3,6 +3,7 @@ package grid
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -20,8 +21,15 @@ func LoadByte(filename string) (Grid[byte], error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to open grid file: %v", err)
 	}
+	defer file.Close()
+
+	return ReadByte(file)
+}
+
+// ReadByte reads a grid of bytes from r, with one row per line.
+func ReadByte(r io.Reader) (Grid[byte], error) {
 	grid := New[byte]()
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		grid = append(grid, []byte(scanner.Text()))
 	}
@@ -36,8 +44,15 @@ func LoadInt(filename string, delim string) (Grid[int], error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to open grid file: %v", err)
 	}
+	defer file.Close()
+
+	return ReadInt(file, delim)
+}
+
+// ReadInt reads a grid of integers from r, with one row per line and delim between values.
+func ReadInt(r io.Reader, delim string) (Grid[int], error) {
 	grid := New[int]()
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for lineNo := 0; scanner.Scan(); lineNo++ {
 		slice, err := slice.StringsToInts(strings.Split(scanner.Text(), delim))
 		if err != nil {
@@ -51,6 +66,52 @@ func LoadInt(filename string, delim string) (Grid[int], error) {
 	return grid, nil
 }
 
+// Height returns the number of rows in the grid.
+func (g Grid[T]) Height() int {
+	return len(g)
+}
+
+// Width returns the number of columns in the grid, i.e. the length of its first row.
+func (g Grid[T]) Width() int {
+	if len(g) == 0 {
+		return 0
+	}
+	return len(g[0])
+}
+
+// InBounds returns true when the coordinate is within the grid.
+func (g Grid[T]) InBounds(c Coordinate) bool {
+	return c.Y >= 0 && c.Y < len(g) && c.X >= 0 && c.X < len(g[c.Y])
+}
+
+// Get returns the value at the coordinate, or false when it is out of bounds.
+func (g Grid[T]) Get(c Coordinate) (T, bool) {
+	if !g.InBounds(c) {
+		var zero T
+		return zero, false
+	}
+	return g[c.Y][c.X], true
+}
+
+// Set sets the value at the coordinate, or returns false when it is out of bounds.
+func (g Grid[T]) Set(c Coordinate, v T) bool {
+	if !g.InBounds(c) {
+		return false
+	}
+	g[c.Y][c.X] = v
+	return true
+}
+
+// Clone returns a copy of the grid which shares no rows with it.
+func (g Grid[T]) Clone() Grid[T] {
+	clone := make(Grid[T], len(g))
+	for y := range g {
+		clone[y] = make([]T, len(g[y]))
+		copy(clone[y], g[y])
+	}
+	return clone
+}
+
 func (g Grid[T]) String() string {
 	s := ""
 	for y := 0; y < len(g); y++ {
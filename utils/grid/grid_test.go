@@ -0,0 +1,97 @@
+package grid
+
+import (
+	"iter"
+	"strings"
+	"testing"
+)
+
+func testGrid(t *testing.T) Grid[byte] {
+	t.Helper()
+
+	g, err := ReadByte(strings.NewReader("abc\ndef\n"))
+	if err != nil {
+		t.Fatalf("failed to read grid: %v", err)
+	}
+	return g
+}
+
+func TestAccessors(t *testing.T) {
+	g := testGrid(t)
+
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Errorf("got a %dx%d grid, want 3x2", g.Width(), g.Height())
+	}
+	if (Grid[byte]{}).Width() != 0 {
+		t.Error("expected an empty grid to have no width")
+	}
+
+	for _, c := range []Coordinate{{X: -1, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: 2}} {
+		if g.InBounds(c) {
+			t.Errorf("expected %v to be out of bounds", &c)
+		}
+		if _, ok := g.Get(c); ok {
+			t.Errorf("expected no value at %v", &c)
+		}
+		if g.Set(c, 'z') {
+			t.Errorf("expected setting %v to fail", &c)
+		}
+	}
+
+	if v, ok := g.Get(Coordinate{X: 2, Y: 1}); !ok || v != 'f' {
+		t.Errorf("got %q (%t) at (1,2), want 'f'", v, ok)
+	}
+	if !g.Set(Coordinate{X: 0, Y: 1}, 'z') || g[1][0] != 'z' {
+		t.Errorf("failed to set (1,0), got row %q", g[1])
+	}
+}
+
+func TestAll(t *testing.T) {
+	g := testGrid(t)
+
+	var sb strings.Builder
+	for c, v := range g.All() {
+		if g[c.Y][c.X] != v {
+			t.Errorf("got %q at %v, want %q", v, &c, g[c.Y][c.X])
+		}
+		sb.WriteByte(v)
+	}
+	if sb.String() != "abcdef" {
+		t.Errorf("iterated over %q, want row by row \"abcdef\"", sb.String())
+	}
+
+	for c := range g.All() {
+		if c.X != 0 || c.Y != 0 {
+			t.Errorf("expected iteration to stop after the first coordinate, got %v", &c)
+		}
+		break
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := testGrid(t)
+
+	tests := []struct {
+		name      string
+		neighbors func(Coordinate) iter.Seq2[Coordinate, byte]
+		c         Coordinate
+		want      string
+	}{
+		{"4 at corner", g.Neighbors4, Coordinate{X: 0, Y: 0}, "bd"},
+		{"4 at edge", g.Neighbors4, Coordinate{X: 1, Y: 1}, "bfd"},
+		{"8 at corner", g.Neighbors8, Coordinate{X: 0, Y: 0}, "bed"},
+		{"8 at edge", g.Neighbors8, Coordinate{X: 1, Y: 0}, "cfeda"},
+	}
+	for _, test := range tests {
+		var sb strings.Builder
+		for n, v := range test.neighbors(test.c) {
+			if g[n.Y][n.X] != v {
+				t.Errorf("%s: got %q at %v, want %q", test.name, v, &n, g[n.Y][n.X])
+			}
+			sb.WriteByte(v)
+		}
+		if sb.String() != test.want {
+			t.Errorf("%s: got neighbors %q, want %q", test.name, sb.String(), test.want)
+		}
+	}
+}
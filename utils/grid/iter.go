@@ -0,0 +1,42 @@
+package grid
+
+import "iter"
+
+// All returns an iterator over every coordinate in the grid and its value, row by row.
+func (g Grid[T]) All() iter.Seq2[Coordinate, T] {
+	return func(yield func(Coordinate, T) bool) {
+		for y := range g {
+			for x := range g[y] {
+				if !yield(Coordinate{X: x, Y: y}, g[y][x]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Neighbors4 returns an iterator over the orthogonal neighbors of the coordinate
+// (clockwise from north) and their values, skipping any which are out of bounds.
+func (g Grid[T]) Neighbors4(c Coordinate) iter.Seq2[Coordinate, T] {
+	return g.neighbors(c, Directions4)
+}
+
+// Neighbors8 returns an iterator over the orthogonal and diagonal neighbors of the coordinate
+// (clockwise from north) and their values, skipping any which are out of bounds.
+func (g Grid[T]) Neighbors8(c Coordinate) iter.Seq2[Coordinate, T] {
+	return g.neighbors(c, Directions8)
+}
+
+func (g Grid[T]) neighbors(c Coordinate, directions []Direction) iter.Seq2[Coordinate, T] {
+	return func(yield func(Coordinate, T) bool) {
+		for _, d := range directions {
+			n := c.Move(d)
+			if !g.InBounds(n) {
+				continue
+			}
+			if !yield(n, g[n.Y][n.X]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package grid
+
+import "github.com/adrianosela/adventofcode/utils/set"
+
+// Region is a group of orthogonally connected cells of a grid which have the same value.
+type Region[T comparable] struct {
+	Value T
+	// Cells are the coordinates of the region's cells, in the order they were found.
+	Cells []Coordinate
+
+	cells set.Set[Coordinate]
+}
+
+// Regions returns every region of the grid, ordered by their first cell (row by row).
+// It fills each region iteratively, such that large regions cannot overflow the stack.
+func Regions[T comparable](g Grid[T]) []*Region[T] {
+	visited := set.New[Coordinate]()
+	regions := []*Region[T]{}
+	for start, v := range g.All() {
+		if visited.Has(start) {
+			continue
+		}
+		visited.Put(start)
+		region := &Region[T]{Value: v, Cells: []Coordinate{start}, cells: set.New(start)}
+		for i := 0; i < len(region.Cells); i++ {
+			for n, nv := range g.Neighbors4(region.Cells[i]) {
+				if nv != v || visited.Has(n) {
+					continue
+				}
+				visited.Put(n)
+				region.cells.Put(n)
+				region.Cells = append(region.Cells, n)
+			}
+		}
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// Contains returns true when the coordinate is one of the region's cells.
+func (r *Region[T]) Contains(c Coordinate) bool {
+	return r.cells.Has(c)
+}
+
+// Area returns the number of cells in the region.
+func (r *Region[T]) Area() int {
+	return len(r.Cells)
+}
+
+// Perimeter returns the number of cell edges on the boundary of the region
+// (both its outside and around any holes), i.e. between its cells and others.
+func (r *Region[T]) Perimeter() int {
+	perimeter := 0
+	for _, c := range r.Cells {
+		for _, d := range Directions4 {
+			if !r.Contains(c.Move(d)) {
+				perimeter++
+			}
+		}
+	}
+	return perimeter
+}
+
+// Sides returns the number of straight sides of the boundary of the region (both its
+// outside and around any holes), which is the same as its number of corners.
+func (r *Region[T]) Sides() int {
+	corners := 0
+	for _, c := range r.Cells {
+		for _, d := range Directions4 {
+			// each pair of adjacent orthogonal directions, e.g. north and east, meet at a corner
+			// of the cell, which is a corner of the region when both are outside it (convex),
+			// or both are inside it but the diagonal between them is not (concave).
+			a, b := r.Contains(c.Move(d)), r.Contains(c.Move(d.TurnRight()))
+			if !a && !b {
+				corners++
+			}
+			if a && b && !r.Contains(c.Move(d).Move(d.TurnRight())) {
+				corners++
+			}
+		}
+	}
+	return corners
+}
+
+// Bounds returns the top left and bottom right (inclusive) corners of the smallest rectangle containing the region.
+func (r *Region[T]) Bounds() (Coordinate, Coordinate) {
+	lo, hi := r.Cells[0], r.Cells[0]
+	for _, c := range r.Cells[1:] {
+		lo = Coordinate{X: min(lo.X, c.X), Y: min(lo.Y, c.Y)}
+		hi = Coordinate{X: max(hi.X, c.X), Y: max(hi.Y, c.Y)}
+	}
+	return lo, hi
+}
+
+// Holes returns the number of holes in the region, i.e. groups of other cells (or cells beyond
+// the edge of the grid) which are enclosed by it, such that they cannot reach its outside with
+// orthogonal steps.
+func (r *Region[T]) Holes() int {
+	lo, hi := r.Bounds()
+	// anything outside of the bounds (by a margin of one cell) is outside of the region
+	lo, hi = lo.Move(NorthWest), hi.Move(SouthEast)
+	inBounds := func(c Coordinate) bool {
+		return c.X >= lo.X && c.X <= hi.X && c.Y >= lo.Y && c.Y <= hi.Y
+	}
+
+	visited := set.New[Coordinate]()
+	fill := func(start Coordinate) {
+		visited.Put(start)
+		queue := []Coordinate{start}
+		for len(queue) > 0 {
+			c := queue[0]
+			queue = queue[1:]
+			for _, d := range Directions4 {
+				n := c.Move(d)
+				if inBounds(n) && !r.Contains(n) && !visited.Has(n) {
+					visited.Put(n)
+					queue = append(queue, n)
+				}
+			}
+		}
+	}
+
+	fill(lo) // the outside
+	holes := 0
+	for y := lo.Y; y <= hi.Y; y++ {
+		for x := lo.X; x <= hi.X; x++ {
+			if c := (Coordinate{X: x, Y: y}); !r.Contains(c) && !visited.Has(c) {
+				fill(c)
+				holes++
+			}
+		}
+	}
+	return holes
+}
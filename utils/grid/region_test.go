@@ -0,0 +1,54 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegions(t *testing.T) {
+	g, err := ReadByte(strings.NewReader("AAAAAA\nAAABBA\nAAABBA\nABBAAA\nABBAAA\nAAAAAA\n"))
+	if err != nil {
+		t.Fatalf("failed to read grid: %v", err)
+	}
+
+	regions := Regions(g)
+	if len(regions) != 3 {
+		t.Fatalf("got %d regions, want 3", len(regions))
+	}
+
+	tests := []struct {
+		area, perimeter, sides, holes int
+		lo, hi                        Coordinate
+	}{
+		{28, 40, 12, 2, Coordinate{X: 0, Y: 0}, Coordinate{X: 5, Y: 5}},
+		{4, 8, 4, 0, Coordinate{X: 3, Y: 1}, Coordinate{X: 4, Y: 2}},
+		{4, 8, 4, 0, Coordinate{X: 1, Y: 3}, Coordinate{X: 2, Y: 4}},
+	}
+	for i, test := range tests {
+		r := regions[i]
+		if r.Area() != test.area || r.Perimeter() != test.perimeter || r.Sides() != test.sides || r.Holes() != test.holes {
+			t.Errorf("region %d (%c): got area %d, perimeter %d, %d sides and %d holes, want %d, %d, %d and %d",
+				i, r.Value, r.Area(), r.Perimeter(), r.Sides(), r.Holes(), test.area, test.perimeter, test.sides, test.holes)
+		}
+		if lo, hi := r.Bounds(); lo != test.lo || hi != test.hi {
+			t.Errorf("region %d (%c): got bounds %v to %v, want %v to %v", i, r.Value, lo, hi, test.lo, test.hi)
+		}
+	}
+
+	if !regions[1].Contains(Coordinate{X: 4, Y: 2}) || regions[1].Contains(Coordinate{X: 2, Y: 3}) {
+		t.Error("expected the second region to contain only its own cells")
+	}
+}
+
+func TestRegionsAreIterative(t *testing.T) {
+	// a region this big would need a very deep recursion to fill
+	row := strings.Repeat("x", 300)
+	g, err := ReadByte(strings.NewReader(strings.Repeat(row+"\n", 300)))
+	if err != nil {
+		t.Fatalf("failed to read grid: %v", err)
+	}
+	regions := Regions(g)
+	if len(regions) != 1 || regions[0].Area() != 300*300 || regions[0].Sides() != 4 {
+		t.Errorf("expected a single square region of 90000 cells")
+	}
+}
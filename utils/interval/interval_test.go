@@ -0,0 +1,101 @@
+package interval
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRangeSet(t *testing.T) {
+	s := NewSet(Of(10, 5), Of(0, 3), Range{Start: 3, End: 5}, Range{Start: 12, End: 20}, Range{Start: 7, End: 7})
+	if got, want := s.Ranges(), []Range{{0, 5}, {10, 20}}; !slices.Equal(got, want) {
+		t.Fatalf("got ranges %v, want %v", got, want)
+	}
+	if s.Len() != 15 {
+		t.Errorf("got length %d, want 15", s.Len())
+	}
+	for x, want := range map[int]bool{-1: false, 0: true, 4: true, 5: false, 10: true, 19: true, 20: false} {
+		if got := s.Contains(x); got != want {
+			t.Errorf("contains %d: got %t, want %t", x, got, want)
+		}
+	}
+	if lo, _ := s.Min(); lo != 0 {
+		t.Errorf("got min %d, want 0", lo)
+	}
+	if hi, _ := s.Max(); hi != 19 {
+		t.Errorf("got max %d, want 19", hi)
+	}
+
+	other := NewSet(Range{Start: 3, End: 12}, Range{Start: 15, End: 17})
+	tests := []struct {
+		name string
+		got  RangeSet
+		want []Range
+	}{
+		{"union", s.Union(other), []Range{{0, 20}}},
+		{"intersection", s.Intersect(other), []Range{{3, 5}, {10, 12}, {15, 17}}},
+		{"difference", s.Difference(other), []Range{{0, 3}, {12, 15}, {17, 20}}},
+		{"reverse difference", other.Difference(s), []Range{{5, 10}}},
+	}
+	for _, test := range tests {
+		if got := test.got.Ranges(); !slices.Equal(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	below, above := s.Split(12)
+	if !slices.Equal(below.Ranges(), []Range{{0, 5}, {10, 12}}) || !slices.Equal(above.Ranges(), []Range{{12, 20}}) {
+		t.Errorf("split at 12: got %v and %v", below, above)
+	}
+}
+
+// seedToSoil is the first mapping layer of the 2023 day 5 sample.
+func seedToSoil() *RangeMap {
+	m := NewMap()
+	m.Add(Of(98, 2), 50)
+	m.Add(Of(50, 48), 52)
+	return m
+}
+
+// soilToFertilizer is the second mapping layer of the 2023 day 5 sample.
+func soilToFertilizer() *RangeMap {
+	m := NewMap()
+	m.Add(Of(15, 37), 0)
+	m.Add(Of(52, 2), 37)
+	m.Add(Of(0, 15), 39)
+	return m
+}
+
+func TestRangeMap(t *testing.T) {
+	m := seedToSoil()
+	for x, want := range map[int]int{79: 81, 14: 14, 55: 57, 13: 13, 98: 50, 99: 51, 100: 100} {
+		if got := m.Map(x); got != want {
+			t.Errorf("mapped %d to %d, want %d", x, got, want)
+		}
+	}
+
+	got := m.MapRange(Range{Start: 45, End: 60}).Ranges()
+	if want := []Range{{45, 50}, {52, 62}}; !slices.Equal(got, want) {
+		t.Errorf("mapped [45,60) to %v, want %v", got, want)
+	}
+
+	// the first piece added wins where pieces overlap
+	m.Add(Of(90, 20), 0)
+	if m.Map(98) != 50 || m.Map(100) != 10 {
+		t.Errorf("expected only unmapped integers to be mapped by an overlapping piece")
+	}
+}
+
+func TestCompose(t *testing.T) {
+	a, b := seedToSoil(), soilToFertilizer()
+	composed := Chain(a, b)
+	for x := -5; x < 110; x++ {
+		if got, want := composed.Map(x), b.Map(a.Map(x)); got != want {
+			t.Errorf("composition mapped %d to %d, want %d", x, got, want)
+		}
+	}
+
+	seeds := NewSet(Of(79, 14), Of(55, 13))
+	if got, want := composed.MapSet(seeds), b.MapSet(a.MapSet(seeds)); !slices.Equal(got.Ranges(), want.Ranges()) {
+		t.Errorf("composition mapped %v to %v, want %v", seeds, got, want)
+	}
+}
@@ -0,0 +1,120 @@
+package interval
+
+import (
+	"math"
+	"slices"
+)
+
+// piece maps every integer in src to that integer plus offset.
+type piece struct {
+	src    Range
+	offset int
+}
+
+// RangeMap is a piecewise linear function on the integers: each of its pieces
+// moves the integers in a source range by some offset, and every integer outside
+// all of them maps to itself. The zero value is the identity.
+type RangeMap struct {
+	// pieces are sorted by source range, which are disjoint
+	pieces []piece
+}
+
+// NewMap returns the identity map, to which pieces can be added with Add.
+func NewMap() *RangeMap {
+	return &RangeMap{}
+}
+
+// Add maps the integers in the given source range to the range of the same length
+// starting at dst. Integers which are already mapped by an earlier piece keep their
+// mapping, i.e. when pieces overlap the first one added wins.
+func (m *RangeMap) Add(src Range, dst int) {
+	offset := dst - src.Start
+	unmapped := NewSet(src)
+	for _, p := range m.pieces {
+		unmapped = unmapped.Difference(NewSet(p.src))
+	}
+	for _, r := range unmapped.ranges {
+		m.insert(piece{src: r, offset: offset})
+	}
+}
+
+func (m *RangeMap) insert(p piece) {
+	if p.offset == 0 || p.src.Empty() {
+		return // the identity is implied
+	}
+	i, _ := slices.BinarySearchFunc(m.pieces, p.src.Start, func(p piece, start int) int {
+		return p.src.Start - start
+	})
+	m.pieces = slices.Insert(m.pieces, i, p)
+}
+
+// Map returns the integer which the given one maps to.
+func (m *RangeMap) Map(x int) int {
+	for _, p := range m.pieces {
+		if p.src.Contains(x) {
+			return x + p.offset
+		}
+	}
+	return x
+}
+
+// MapRange returns the set of integers which the given range maps to.
+func (m *RangeMap) MapRange(r Range) RangeSet {
+	mapped := RangeSet{}
+	for _, p := range m.segments() {
+		mapped.Add(p.src.Intersect(r).Shift(p.offset))
+	}
+	return mapped
+}
+
+// MapSet returns the set of integers which the given set maps to.
+func (m *RangeMap) MapSet(s RangeSet) RangeSet {
+	mapped := RangeSet{}
+	for _, r := range s.ranges {
+		mapped = mapped.Union(m.MapRange(r))
+	}
+	return mapped
+}
+
+// Compose returns the map which applies m and then next, such
+// that a chain of maps can be collapsed into a single one.
+func (m *RangeMap) Compose(next *RangeMap) *RangeMap {
+	composed := NewMap()
+	nextSegments := next.segments()
+	for _, p := range m.segments() {
+		image := p.src.Shift(p.offset)
+		for _, q := range nextSegments {
+			if r := image.Intersect(q.src); !r.Empty() {
+				composed.insert(piece{src: r.Shift(-p.offset), offset: p.offset + q.offset})
+			}
+		}
+	}
+	return composed
+}
+
+// Chain returns the map which applies each of the given maps in order.
+func Chain(maps ...*RangeMap) *RangeMap {
+	chained := NewMap()
+	for _, m := range maps {
+		chained = chained.Compose(m)
+	}
+	return chained
+}
+
+// segments returns the pieces of the map together with the identity pieces
+// between them, such that they cover every integer, in order.
+func (m *RangeMap) segments() []piece {
+	segments := []piece{}
+	start := math.MinInt
+	for _, p := range m.pieces {
+		if start < p.src.Start {
+			segments = append(segments, piece{src: Range{Start: start, End: p.src.Start}})
+		}
+		segments = append(segments, p)
+		start = p.src.End
+	}
+	if start < math.MaxInt {
+		segments = append(segments, piece{src: Range{Start: start, End: math.MaxInt}})
+	}
+	return segments
+}
@@ -0,0 +1,58 @@
+package interval
+
+import "fmt"
+
+// Range is the half-open range of integers [Start, End), which is empty when End <= Start.
+type Range struct {
+	Start int
+	End   int
+}
+
+// Of returns the range of the given length which starts at the given integer.
+func Of(start, length int) Range {
+	return Range{Start: start, End: start + length}
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("[%d,%d)", r.Start, r.End)
+}
+
+// Len returns the number of integers in the range.
+func (r Range) Len() int {
+	if r.Empty() {
+		return 0
+	}
+	return r.End - r.Start
+}
+
+// Empty returns true when the range contains no integers.
+func (r Range) Empty() bool {
+	return r.End <= r.Start
+}
+
+// Contains returns true when the given integer is in the range.
+func (r Range) Contains(x int) bool {
+	return r.Start <= x && x < r.End
+}
+
+// Overlaps returns true when the ranges have at least one integer in common.
+func (r Range) Overlaps(r2 Range) bool {
+	return !r.Intersect(r2).Empty()
+}
+
+// Intersect returns the integers in both ranges, which may be an empty range.
+func (r Range) Intersect(r2 Range) Range {
+	return Range{Start: max(r.Start, r2.Start), End: min(r.End, r2.End)}
+}
+
+// Shift returns the range moved by the given offset.
+func (r Range) Shift(offset int) Range {
+	return Range{Start: r.Start + offset, End: r.End + offset}
+}
+
+// Split returns the integers in the range below the given integer and those at or above it.
+// Either may be an empty range.
+func (r Range) Split(at int) (below Range, above Range) {
+	at = min(max(at, r.Start), r.End)
+	return Range{Start: r.Start, End: at}, Range{Start: at, End: r.End}
+}
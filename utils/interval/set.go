@@ -0,0 +1,156 @@
+package interval
+
+import (
+	"slices"
+	"strings"
+)
+
+// RangeSet is a set of integers, held as sorted, disjoint and non-adjacent
+// ranges such that large sets can be operated on without iterating over them.
+// The zero value is the empty set.
+type RangeSet struct {
+	ranges []Range
+}
+
+// NewSet returns the set of the integers in any of the given ranges.
+func NewSet(ranges ...Range) RangeSet {
+	s := RangeSet{}
+	for _, r := range ranges {
+		s.Add(r)
+	}
+	return s
+}
+
+func (s RangeSet) String() string {
+	parts := make([]string, len(s.ranges))
+	for i, r := range s.ranges {
+		parts[i] = r.String()
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// Ranges returns the sorted, disjoint and non-adjacent ranges of the set.
+func (s RangeSet) Ranges() []Range {
+	return slices.Clone(s.ranges)
+}
+
+// Len returns the number of integers in the set.
+func (s RangeSet) Len() int {
+	total := 0
+	for _, r := range s.ranges {
+		total += r.Len()
+	}
+	return total
+}
+
+// Empty returns true when the set contains no integers.
+func (s RangeSet) Empty() bool {
+	return len(s.ranges) == 0
+}
+
+// Min returns the lowest integer in the set, or false when it is empty.
+func (s RangeSet) Min() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.ranges[0].Start, true
+}
+
+// Max returns the highest integer in the set, or false when it is empty.
+func (s RangeSet) Max() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.ranges[len(s.ranges)-1].End - 1, true
+}
+
+// Contains returns true when the given integer is in the set.
+func (s RangeSet) Contains(x int) bool {
+	i, _ := slices.BinarySearchFunc(s.ranges, x, func(r Range, x int) int {
+		if r.End <= x {
+			return -1
+		}
+		return 1
+	})
+	return i < len(s.ranges) && s.ranges[i].Contains(x)
+}
+
+// Add adds the integers in the given range to the set.
+func (s *RangeSet) Add(r Range) {
+	if r.Empty() {
+		return
+	}
+	// the ranges which overlap or touch r are merged into it
+	lo := 0
+	for lo < len(s.ranges) && s.ranges[lo].End < r.Start {
+		lo++
+	}
+	hi := lo
+	for hi < len(s.ranges) && s.ranges[hi].Start <= r.End {
+		r = Range{Start: min(r.Start, s.ranges[hi].Start), End: max(r.End, s.ranges[hi].End)}
+		hi++
+	}
+	s.ranges = slices.Replace(s.ranges, lo, hi, r)
+}
+
+// Union returns the integers in either set.
+func (s RangeSet) Union(s2 RangeSet) RangeSet {
+	union := RangeSet{ranges: slices.Clone(s.ranges)}
+	for _, r := range s2.ranges {
+		union.Add(r)
+	}
+	return union
+}
+
+// Intersect returns the integers in both sets.
+func (s RangeSet) Intersect(s2 RangeSet) RangeSet {
+	intersection := RangeSet{}
+	for i, j := 0, 0; i < len(s.ranges) && j < len(s2.ranges); {
+		if r := s.ranges[i].Intersect(s2.ranges[j]); !r.Empty() {
+			intersection.ranges = append(intersection.ranges, r)
+		}
+		// the range which ends first cannot overlap any later range of the other set
+		if s.ranges[i].End < s2.ranges[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return intersection
+}
+
+// Difference returns the integers in s which are not in s2.
+func (s RangeSet) Difference(s2 RangeSet) RangeSet {
+	difference := RangeSet{}
+	j := 0
+	for _, r := range s.ranges {
+		for j < len(s2.ranges) && s2.ranges[j].End <= r.Start {
+			j++
+		}
+		for k := j; k < len(s2.ranges) && s2.ranges[k].Start < r.End; k++ {
+			below, _ := r.Split(s2.ranges[k].Start)
+			if !below.Empty() {
+				difference.ranges = append(difference.ranges, below)
+			}
+			_, r = r.Split(s2.ranges[k].End)
+		}
+		if !r.Empty() {
+			difference.ranges = append(difference.ranges, r)
+		}
+	}
+	return difference
+}
+
+// Split returns the integers in the set below the given integer and those at or above it.
+func (s RangeSet) Split(at int) (below RangeSet, above RangeSet) {
+	for _, r := range s.ranges {
+		lo, hi := r.Split(at)
+		if !lo.Empty() {
+			below.ranges = append(below.ranges, lo)
+		}
+		if !hi.Empty() {
+			above.ranges = append(above.ranges, hi)
+		}
+	}
+	return below, above
+}
@@ -0,0 +1,33 @@
+package linalg
+
+// ExtendedGCD returns the greatest common divisor g of a and b (which is never
+// negative), together with the Bézout coefficients x and y for which a*x + b*y = g.
+func ExtendedGCD(a, b int) (g, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// LinearDiophantine solves a*x + b*y = c over the integers. When it has solutions, they are
+// exactly (x + k*dx, y + k*dy) for every integer k. It returns false when there are none.
+// When both a and b are zero, every (x, y) solves c = 0, which is reported as dx = dy = 0.
+func LinearDiophantine(a, b, c int) (x, y, dx, dy int, ok bool) {
+	g, u, v := ExtendedGCD(a, b)
+	if g == 0 {
+		return 0, 0, 0, 0, c == 0
+	}
+	if c%g != 0 {
+		return 0, 0, 0, 0, false
+	}
+	return u * (c / g), v * (c / g), b / g, -a / g, true
+}
@@ -0,0 +1,101 @@
+package linalg
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExtendedGCD(t *testing.T) {
+	for _, test := range [][3]int{{240, 46, 2}, {46, 240, 2}, {-12, 18, 6}, {7, 0, 7}, {0, 0, 0}} {
+		a, b, want := test[0], test[1], test[2]
+		g, x, y := ExtendedGCD(a, b)
+		if g != want || a*x+b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d, want gcd %d with a*x + b*y = gcd", a, b, g, x, y, want)
+		}
+	}
+
+	x, y, dx, dy, ok := LinearDiophantine(94, 22, 8400)
+	if !ok {
+		t.Fatal("expected 94x + 22y = 8400 to have solutions")
+	}
+	for k := -3; k <= 3; k++ {
+		if got := 94*(x+k*dx) + 22*(y+k*dy); got != 8400 {
+			t.Errorf("solution %d gives %d, want 8400", k, got)
+		}
+	}
+	if _, _, _, _, ok := LinearDiophantine(4, 6, 7); ok {
+		t.Error("expected 4x + 6y = 7 to have no solutions")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	s, err := Solve([][]int{{94, 22}, {34, 67}}, []int{8400, 5400})
+	if err != nil {
+		t.Fatalf("failed to solve: %v", err)
+	}
+	if x, ok := s.Integer(); !ok || !slices.Equal(x, []int{80, 40}) {
+		t.Errorf("got %v (%t), want [80 40]", x, ok)
+	}
+
+	s, err = Solve([][]int{{26, 67}, {66, 21}}, []int{12748, 12176})
+	if err != nil {
+		t.Fatalf("failed to solve: %v", err)
+	}
+	if _, ok := s.Integer(); ok || !s.Unique() {
+		t.Error("expected a unique solution which is not integer")
+	}
+
+	s, err = Solve([][]int{{1, 2}, {2, 4}}, []int{3, 7})
+	if err != nil {
+		t.Fatalf("failed to solve: %v", err)
+	}
+	if s.Consistent {
+		t.Error("expected parallel equations with different constants to be inconsistent")
+	}
+
+	s, err = Solve([][]int{{1, 2, 0}, {2, 4, 0}}, []int{3, 6})
+	if err != nil {
+		t.Fatalf("failed to solve: %v", err)
+	}
+	if s.Rank != 1 || !slices.Equal(s.Free, []int{1, 2}) {
+		t.Errorf("got rank %d with free variables %v, want rank 1 with [1 2]", s.Rank, s.Free)
+	}
+
+	if _, err := Solve([][]int{{1, 2}, {3}}, []int{1, 2}); err == nil {
+		t.Error("expected an error for a ragged matrix")
+	}
+}
+
+func TestMinimizeNonNegative(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     [][]int
+		b     []int
+		cost  []int
+		limit int
+		want  []int
+	}{
+		{"unique", [][]int{{94, 22}, {34, 67}}, []int{8400, 5400}, []int{3, 1}, 100, []int{80, 40}},
+		{"beyond limit", [][]int{{94, 22}, {34, 67}}, []int{8400, 5400}, []int{3, 1}, 50, nil},
+		{"collinear, cheaper b", [][]int{{2, 4}, {3, 6}}, []int{20, 30}, []int{3, 1}, Unbounded, []int{0, 5}},
+		{"collinear, cheaper a", [][]int{{2, 4}, {3, 6}}, []int{20, 30}, []int{1, 3}, Unbounded, []int{10, 0}},
+		{"collinear, odd remainder", [][]int{{4, 6}, {4, 6}}, []int{22, 22}, []int{1, 3}, Unbounded, []int{4, 1}},
+		{"collinear, unreachable", [][]int{{2, 4}, {2, 4}}, []int{7, 7}, []int{3, 1}, Unbounded, nil},
+		{"zero component", [][]int{{0, 5}, {3, 4}}, []int{25, 29}, []int{3, 1}, Unbounded, []int{3, 5}},
+		{"two free variables", [][]int{{1, 2, 3}}, []int{10}, []int{5, 4, 1}, 10, []int{1, 0, 3}},
+	}
+	for _, test := range tests {
+		got, ok, err := MinimizeNonNegative(test.a, test.b, test.cost, test.limit)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if ok != (test.want != nil) || !slices.Equal(got, test.want) {
+			t.Errorf("%s: got %v (%t), want %v", test.name, got, ok, test.want)
+		}
+	}
+
+	if _, _, err := MinimizeNonNegative([][]int{{1, 2, 3}}, []int{10}, []int{1, 1, 1}, Unbounded); err == nil {
+		t.Error("expected an error for several free variables without a limit")
+	}
+}
@@ -0,0 +1,226 @@
+package linalg
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// Unbounded as the limit of MinimizeNonNegative puts no upper bound on the unknowns.
+const Unbounded = -1
+
+// MinimizeNonNegative finds the solution of A·x = b in non-negative integers, each at most the given
+// limit (unless Unbounded), for which the cost (i.e. the dot product of cost and x) is lowest. It
+// returns false when there is no such solution.
+//
+// Unlike Solve, it also handles under-determined systems (e.g. when two columns of A are collinear):
+// with one free variable the solutions lie on a line, along which the cheapest integer point is
+// found exactly, and with more it searches every value up to the limit of all but one of them.
+func MinimizeNonNegative(a [][]int, b []int, cost []int, limit int) ([]int, bool, error) {
+	s, err := Solve(a, b)
+	if err != nil {
+		return nil, false, err
+	}
+	if !s.Consistent {
+		return nil, false, nil
+	}
+	if len(cost) != len(s.Particular) {
+		return nil, false, fmt.Errorf("got %d costs for %d unknowns", len(cost), len(s.Particular))
+	}
+
+	switch len(s.Free) {
+	case 0:
+		x, ok := s.Integer()
+		if !ok || !withinLimit(x, limit) {
+			return nil, false, nil
+		}
+		return x, true, nil
+	case 1:
+		return minimizeAlongLine(s.Particular, s.Null[0], cost, limit)
+	}
+
+	if limit == Unbounded {
+		return nil, false, fmt.Errorf("cannot search %d free variables without a limit", len(s.Free))
+	}
+	// fix the first free variable to each possible value with an extra equation
+	fixed := slices.Clone(a)
+	fixed = append(fixed, make([]int, len(cost)))
+	fixed[len(fixed)-1][s.Free[0]] = 1
+	constants := append(slices.Clone(b), 0)
+
+	var best []int
+	bestCost := 0
+	for v := 0; v <= limit; v++ {
+		constants[len(constants)-1] = v
+		x, ok, err := MinimizeNonNegative(fixed, constants, cost, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok && (best == nil || dot(cost, x) < bestCost) {
+			best, bestCost = x, dot(cost, x)
+		}
+	}
+	return best, best != nil, nil
+}
+
+// minimizeAlongLine finds the cheapest of the solutions p + t*d, for integers t, which are non-negative
+// integers within the limit. Each unknown bounds t from one side, and being an integer restricts t to
+// a residue class, so the cheapest is the first member of the class from the side the cost decreases.
+func minimizeAlongLine(p, d []*big.Rat, cost []int, limit int) ([]int, bool, error) {
+	var lo, hi *big.Int // nil when unbounded
+	residue, modulus := big.NewInt(0), big.NewInt(1)
+	slope := new(big.Rat) // the change of the cost per unit of t
+
+	for i := range p {
+		slope.Add(slope, new(big.Rat).Mul(d[i], big.NewRat(int64(cost[i]), 1)))
+		if d[i].Sign() == 0 {
+			if !p[i].IsInt() || p[i].Sign() < 0 || (limit != Unbounded && p[i].Cmp(big.NewRat(int64(limit), 1)) > 0) {
+				return nil, false, nil
+			}
+			continue
+		}
+
+		// p[i] + t*d[i] >= 0, and <= limit
+		zero := new(big.Rat).Quo(new(big.Rat).Neg(p[i]), d[i])
+		if d[i].Sign() > 0 {
+			lo = maxInt(lo, ceil(zero))
+		} else {
+			hi = minInt(hi, floor(zero))
+		}
+		if limit != Unbounded {
+			top := new(big.Rat).Quo(new(big.Rat).Sub(big.NewRat(int64(limit), 1), p[i]), d[i])
+			if d[i].Sign() > 0 {
+				hi = minInt(hi, floor(top))
+			} else {
+				lo = maxInt(lo, ceil(top))
+			}
+		}
+
+		// p[i] + t*d[i] is an integer when, scaled by the denominators' lcm, it is divisible by it
+		denom := lcm(p[i].Denom(), d[i].Denom())
+		coef := new(big.Int).Mul(d[i].Num(), new(big.Int).Quo(denom, d[i].Denom()))
+		rhs := new(big.Int).Neg(new(big.Int).Mul(p[i].Num(), new(big.Int).Quo(denom, p[i].Denom())))
+		r, m, ok := solveCongruence(coef, rhs, denom)
+		if !ok {
+			return nil, false, nil
+		}
+		if residue, modulus, ok = combine(residue, modulus, r, m); !ok {
+			return nil, false, nil
+		}
+	}
+
+	var t *big.Int
+	switch {
+	case slope.Sign() > 0 || (slope.Sign() == 0 && lo != nil):
+		if lo == nil {
+			return nil, false, fmt.Errorf("the cost has no lower bound")
+		}
+		// the lowest t >= lo in the residue class
+		t = new(big.Int).Sub(residue, lo)
+		t.Add(lo, t.Mod(t, modulus))
+		if hi != nil && t.Cmp(hi) > 0 {
+			return nil, false, nil
+		}
+	case hi != nil:
+		// the highest t <= hi in the residue class
+		t = new(big.Int).Sub(hi, residue)
+		t.Sub(hi, t.Mod(t, modulus))
+		if lo != nil && t.Cmp(lo) < 0 {
+			return nil, false, nil
+		}
+	case slope.Sign() == 0:
+		t = residue
+	default:
+		return nil, false, fmt.Errorf("the cost has no lower bound")
+	}
+
+	x := make([]*big.Rat, len(p))
+	tr := new(big.Rat).SetInt(t)
+	for i := range p {
+		x[i] = new(big.Rat).Mul(d[i], tr)
+		x[i].Add(x[i], p[i])
+	}
+	ints, ok := toInts(x)
+	return ints, ok, nil
+}
+
+// solveCongruence solves a*t ≡ c (mod m) for t, returning the solutions as t ≡ r (mod n).
+func solveCongruence(a, c, m *big.Int) (r, n *big.Int, ok bool) {
+	a = new(big.Int).Mod(a, m)
+	g := new(big.Int).GCD(nil, nil, a, m)
+	if new(big.Int).Mod(c, g).Sign() != 0 {
+		return nil, nil, false
+	}
+	n = new(big.Int).Quo(m, g)
+	if n.Cmp(big.NewInt(1)) == 0 {
+		return big.NewInt(0), n, true
+	}
+	inv := new(big.Int).ModInverse(new(big.Int).Quo(a, g), n)
+	r = new(big.Int).Mul(new(big.Int).Quo(c, g), inv)
+	return r.Mod(r, n), n, true
+}
+
+// combine returns the integers which are both r1 (mod m1) and r2 (mod m2) as r (mod m),
+// i.e. the Chinese remainder theorem for moduli which need not be coprime.
+func combine(r1, m1, r2, m2 *big.Int) (r, m *big.Int, ok bool) {
+	g := new(big.Int).GCD(nil, nil, m1, m2)
+	diff := new(big.Int).Sub(r2, r1)
+	if new(big.Int).Mod(diff, g).Sign() != 0 {
+		return nil, nil, false
+	}
+	m2g := new(big.Int).Quo(m2, g)
+	k := big.NewInt(0)
+	if m2g.Cmp(big.NewInt(1)) != 0 {
+		inv := new(big.Int).ModInverse(new(big.Int).Mod(new(big.Int).Quo(m1, g), m2g), m2g)
+		k.Mul(new(big.Int).Quo(diff, g), inv)
+		k.Mod(k, m2g)
+	}
+	m = new(big.Int).Mul(m1, m2g)
+	r = new(big.Int).Add(r1, new(big.Int).Mul(m1, k))
+	return r.Mod(r, m), m, true
+}
+
+func lcm(a, b *big.Int) *big.Int {
+	g := new(big.Int).GCD(nil, nil, a, b)
+	return new(big.Int).Mul(a, new(big.Int).Quo(b, g))
+}
+
+func floor(q *big.Rat) *big.Int {
+	// the denominator is always positive, for which Div rounds down
+	return new(big.Int).Div(q.Num(), q.Denom())
+}
+
+func ceil(q *big.Rat) *big.Int {
+	return new(big.Int).Neg(floor(new(big.Rat).Neg(q)))
+}
+
+func maxInt(a, b *big.Int) *big.Int {
+	if a == nil || b.Cmp(a) > 0 {
+		return b
+	}
+	return a
+}
+
+func minInt(a, b *big.Int) *big.Int {
+	if a == nil || b.Cmp(a) < 0 {
+		return b
+	}
+	return a
+}
+
+func withinLimit(x []int, limit int) bool {
+	for _, v := range x {
+		if v < 0 || (limit != Unbounded && v > limit) {
+			return false
+		}
+	}
+	return true
+}
+
+func dot(a, b []int) int {
+	sum := 0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,146 @@
+package linalg
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Solution describes every solution of a system of linear equations A·x = b, as found by Solve.
+type Solution struct {
+	// Consistent is false when the system has no solution at all, in which case the other fields are unset.
+	Consistent bool
+	// Rank is the rank of A, i.e. the number of independent equations.
+	Rank int
+	// Particular is a solution of the system, in which every free variable is zero.
+	Particular []*big.Rat
+	// Free are the indices of the free variables, which can take any value.
+	Free []int
+	// Null holds for each free variable the change of the solution per unit of it,
+	// such that every solution is Particular plus some combination of Null.
+	Null [][]*big.Rat
+}
+
+// Solve solves the system of linear equations A·x = b with Gaussian elimination over the
+// rationals, which is exact no matter how large the coefficients are (unlike floats).
+// Each row of A holds the coefficients of one equation, whose constant is in b.
+func Solve(a [][]int, b []int) (*Solution, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("got %d equations but %d constants", len(a), len(b))
+	}
+	n := 0
+	if len(a) > 0 {
+		n = len(a[0])
+	}
+
+	// the augmented matrix [A | b]
+	m := make([][]*big.Rat, len(a))
+	for i, row := range a {
+		if len(row) != n {
+			return nil, fmt.Errorf("equation %d has %d coefficients, want %d", i, len(row), n)
+		}
+		m[i] = make([]*big.Rat, n+1)
+		for j, v := range row {
+			m[i][j] = big.NewRat(int64(v), 1)
+		}
+		m[i][n] = big.NewRat(int64(b[i]), 1)
+	}
+
+	pivots := reduce(m, n)
+	for _, row := range m[len(pivots):] {
+		if row[n].Sign() != 0 {
+			return &Solution{Consistent: false}, nil // 0 = c for some non-zero c
+		}
+	}
+
+	s := &Solution{Consistent: true, Rank: len(pivots), Particular: zeros(n)}
+	isPivot := make([]bool, n)
+	for r, col := range pivots {
+		isPivot[col] = true
+		s.Particular[col].Set(m[r][n])
+	}
+	for col := 0; col < n; col++ {
+		if isPivot[col] {
+			continue
+		}
+		null := zeros(n)
+		null[col].SetInt64(1)
+		for r, pivot := range pivots {
+			null[pivot].Neg(m[r][col])
+		}
+		s.Free = append(s.Free, col)
+		s.Null = append(s.Null, null)
+	}
+	return s, nil
+}
+
+// Unique returns true when the system has exactly one solution.
+func (s *Solution) Unique() bool {
+	return s.Consistent && len(s.Free) == 0
+}
+
+// Integer returns the unique solution of the system, or false
+// when it has none, several, or one which is not all integers.
+func (s *Solution) Integer() ([]int, bool) {
+	if !s.Unique() {
+		return nil, false
+	}
+	return toInts(s.Particular)
+}
+
+// reduce brings the augmented matrix with n coefficient columns into reduced
+// row echelon form, returning the column of the pivot of each non-zero row.
+func reduce(m [][]*big.Rat, n int) []int {
+	pivots := []int{}
+	tmp := new(big.Rat)
+	for col := 0; col < n && len(pivots) < len(m); col++ {
+		r := len(pivots)
+		found := -1
+		for i := r; i < len(m); i++ {
+			if m[i][col].Sign() != 0 {
+				found = i
+				break
+			}
+		}
+		if found == -1 {
+			continue
+		}
+		m[r], m[found] = m[found], m[r]
+
+		// scale the pivot to one, then clear the column in every other row
+		inv := new(big.Rat).Inv(m[r][col])
+		for j := col; j <= n; j++ {
+			m[r][j].Mul(m[r][j], inv)
+		}
+		for i := range m {
+			if i == r || m[i][col].Sign() == 0 {
+				continue
+			}
+			factor := new(big.Rat).Set(m[i][col])
+			for j := col; j <= n; j++ {
+				m[i][j].Sub(m[i][j], tmp.Mul(factor, m[r][j]))
+			}
+		}
+		pivots = append(pivots, col)
+	}
+	return pivots
+}
+
+func zeros(n int) []*big.Rat {
+	v := make([]*big.Rat, n)
+	for i := range v {
+		v[i] = new(big.Rat)
+	}
+	return v
+}
+
+// toInts returns the vector as integers, or false when any is not an integer or overflows an int.
+func toInts(v []*big.Rat) ([]int, bool) {
+	ints := make([]int, len(v))
+	for i, x := range v {
+		if !x.IsInt() || !x.Num().IsInt64() {
+			return nil, false
+		}
+		ints[i] = int(x.Num().Int64())
+	}
+	return ints, true
+}
@@ -0,0 +1,66 @@
+package numtheory
+
+import "github.com/adrianosela/adventofcode/utils/linalg"
+
+// GCD returns the greatest common divisor of the integers, which is never negative.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// LCM returns the least common multiple of the integers (e.g. the period
+// of several cycles which run at once), or 0 when any of them is 0.
+func LCM(nums ...int) int {
+	lcm := 1
+	for _, n := range nums {
+		if n == 0 {
+			return 0
+		}
+		lcm = lcm / GCD(lcm, n) * n
+		if lcm < 0 {
+			lcm = -lcm
+		}
+	}
+	return lcm
+}
+
+// Mod returns a modulo m in [0, m), unlike the % operator which keeps the sign of a.
+func Mod(a, m int) int {
+	return ((a % m) + m) % m
+}
+
+// ModInverse returns the x in [0, m) for which a*x ≡ 1 (mod m),
+// or false when there is none (i.e. a and m are not coprime).
+func ModInverse(a, m int) (int, bool) {
+	g, x, _ := linalg.ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, false
+	}
+	return Mod(x, m), true
+}
+
+// CRT returns the integers x which are residues[i] modulo moduli[i] for every i, as x ≡ r (mod m),
+// by the Chinese remainder theorem. The moduli need not be coprime, in which case there may be no
+// such integer, for which it returns false.
+func CRT(residues, moduli []int) (r, m int, ok bool) {
+	r, m = 0, 1
+	for i := range residues {
+		// find k for which r + m*k ≡ residues[i] (mod moduli[i])
+		mi := moduli[i]
+		g, inv, _ := linalg.ExtendedGCD(m, mi)
+		diff := residues[i] - r
+		if diff%g != 0 {
+			return 0, 0, false
+		}
+		step := mi / g
+		k := Mod((diff/g)%step*Mod(inv, step), step)
+		r = Mod(r+m*k, m*step)
+		m *= step
+	}
+	return r, m, true
+}
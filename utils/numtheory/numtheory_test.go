@@ -0,0 +1,76 @@
+package numtheory
+
+import "testing"
+
+func TestGCDAndLCM(t *testing.T) {
+	if got := GCD(-12, 18); got != 6 {
+		t.Errorf("GCD(-12, 18) = %d, want 6", got)
+	}
+	if got := LCM(101, 103); got != 10403 {
+		t.Errorf("LCM(101, 103) = %d, want 10403", got)
+	}
+	if got := LCM(4, 6, 10); got != 60 {
+		t.Errorf("LCM(4, 6, 10) = %d, want 60", got)
+	}
+	if got := Mod(-3, 7); got != 4 {
+		t.Errorf("Mod(-3, 7) = %d, want 4", got)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	if x, ok := ModInverse(101, 103); !ok || 101*x%103 != 1 {
+		t.Errorf("ModInverse(101, 103) = %d (%t), want the inverse", x, ok)
+	}
+	if x, ok := ModInverse(-3, 7); !ok || x != 2 {
+		t.Errorf("ModInverse(-3, 7) = %d (%t), want 2", x, ok)
+	}
+	if _, ok := ModInverse(6, 9); ok {
+		t.Error("expected 6 to have no inverse modulo 9")
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		residues, moduli []int
+		r, m             int
+		ok               bool
+	}{
+		{[]int{2, 3, 2}, []int{3, 5, 7}, 23, 105, true},
+		{[]int{68, 76}, []int{101, 103}, 10067, 10403, true},
+		{[]int{3, 5}, []int{4, 6}, 11, 12, true},
+		{[]int{1, 2}, []int{4, 6}, 0, 0, false},
+		{[]int{-1}, []int{5}, 4, 5, true},
+	}
+	for _, test := range tests {
+		r, m, ok := CRT(test.residues, test.moduli)
+		if r != test.r || m != test.m || ok != test.ok {
+			t.Errorf("CRT(%v, %v) = %d, %d, %t, want %d, %d, %t", test.residues, test.moduli, r, m, ok, test.r, test.m, test.ok)
+		}
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	// adding 7 modulo 22 goes through every residue before repeating, without a tail
+	next := func(x int) int { return (x + 7) % 22 }
+	id := func(x int) int { return x }
+	for name, find := range map[string]func(int, func(int) int, func(int) int) (int, int){"brent": Brent[int, int], "floyd": Floyd[int, int]} {
+		if mu, lambda := find(3, next, id); mu != 0 || lambda != 22 {
+			t.Errorf("%s: got cycle at %d of length %d, want at 0 of length 22", name, mu, lambda)
+		}
+	}
+
+	// x -> x*x + 1 modulo 255 from 3 enters a cycle after a tail
+	square := func(x int) int { return (x*x + 1) % 255 }
+	bmu, blambda := Brent(3, square, id)
+	fmu, flambda := Floyd(3, square, id)
+	if bmu != fmu || blambda != flambda {
+		t.Errorf("brent found a cycle at %d of length %d, floyd at %d of length %d", bmu, blambda, fmu, flambda)
+	}
+	seq := []int{3}
+	for len(seq) < bmu+blambda+1 {
+		seq = append(seq, square(seq[len(seq)-1]))
+	}
+	if seq[bmu] != seq[bmu+blambda] || (bmu > 0 && seq[bmu-1] == seq[bmu+blambda-1]) {
+		t.Errorf("cycle at %d of length %d does not match the sequence %v", bmu, blambda, seq)
+	}
+}
@@ -0,0 +1,56 @@
+package numtheory
+
+// Brent finds the cycle which the sequence start, next(start), next(next(start)), ...
+// eventually enters, returning the index of the first state in it and its length.
+// States are compared by their keys (e.g. a hash of them), such that they can be
+// of any type. It only keeps two states at a time, which suits large states.
+func Brent[S any, K comparable](start S, next func(S) S, key func(S) K) (mu, lambda int) {
+	// find the length by moving the hare ahead until it meets the tortoise,
+	// which jumps to the hare at every power of two
+	power, lambda := 1, 1
+	tortoise, hare := start, next(start)
+	for key(tortoise) != key(hare) {
+		if power == lambda {
+			tortoise = hare
+			power *= 2
+			lambda = 0
+		}
+		hare = next(hare)
+		lambda++
+	}
+
+	// find the start with the hare lambda states ahead of the tortoise
+	tortoise, hare = start, start
+	for i := 0; i < lambda; i++ {
+		hare = next(hare)
+	}
+	for key(tortoise) != key(hare) {
+		tortoise, hare = next(tortoise), next(hare)
+		mu++
+	}
+	return mu, lambda
+}
+
+// Floyd finds the same cycle as Brent, with the tortoise and hare algorithm
+// (i.e. the hare moving twice as fast as the tortoise), which usually needs
+// more steps than Brent does.
+func Floyd[S any, K comparable](start S, next func(S) S, key func(S) K) (mu, lambda int) {
+	tortoise, hare := next(start), next(next(start))
+	for key(tortoise) != key(hare) {
+		tortoise, hare = next(tortoise), next(next(hare))
+	}
+
+	// the tortoise is now a multiple of the length past the start, so
+	// moving both at the same speed, they meet at the start of the cycle
+	tortoise = start
+	for key(tortoise) != key(hare) {
+		tortoise, hare = next(tortoise), next(hare)
+		mu++
+	}
+
+	lambda = 1
+	for hare = next(tortoise); key(tortoise) != key(hare); hare = next(hare) {
+		lambda++
+	}
+	return mu, lambda
+}
@@ -0,0 +1,193 @@
+package poset
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/set"
+)
+
+// Poset is a partial order given by rules of the form "before|after", i.e. a
+// directed graph with an edge from every node to each node that must come after it.
+type Poset[N comparable] struct {
+	after map[N]set.Set[N]
+}
+
+// New returns a partial order without any rules.
+func New[N comparable]() *Poset[N] {
+	return &Poset[N]{after: make(map[N]set.Set[N])}
+}
+
+// Add adds the rule that before must come before after.
+func (p *Poset[N]) Add(before, after N) {
+	if _, ok := p.after[before]; !ok {
+		p.after[before] = set.New[N]()
+	}
+	p.after[before].Put(after)
+}
+
+// Before returns true when there is a rule that a must come before b. Note that rules are
+// not transitive, i.e. a|b and b|c do not make a come before c unless there is a rule a|c.
+func (p *Poset[N]) Before(a, b N) bool {
+	return p.after[a].Has(b)
+}
+
+// Compare returns -1 when a must come before b, 1 when b must come before a and 0 otherwise,
+// for use with slices.SortFunc. It only sorts correctly when the rules between the sorted nodes
+// order every pair of them (as sorting needs a consistent order), otherwise use Sort.
+func (p *Poset[N]) Compare(a, b N) int {
+	switch {
+	case p.Before(a, b):
+		return -1
+	case p.Before(b, a):
+		return 1
+	}
+	return 0
+}
+
+// IsSorted returns true when no two of the nodes are in the opposite order of a rule.
+// It takes time linear in the number of nodes and of the rules between them.
+func (p *Poset[N]) IsSorted(nodes []N) bool {
+	position := make(map[N]int, len(nodes))
+	for i, n := range nodes {
+		position[n] = i
+	}
+	for i, n := range nodes {
+		for after := range p.after[n] {
+			if j, ok := position[after]; ok && j < i {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CycleError is returned when the rules between some nodes contradict each other.
+type CycleError[N comparable] struct {
+	// Cycle are nodes which each must come before the next, and the last before the first.
+	Cycle []N
+}
+
+func (e *CycleError[N]) Error() string {
+	parts := make([]string, 0, len(e.Cycle)+1)
+	for _, n := range append(e.Cycle, e.Cycle[0]) {
+		parts = append(parts, fmt.Sprint(n))
+	}
+	return "rules contain a cycle: " + strings.Join(parts, " -> ")
+}
+
+// Sort returns the nodes ordered such that every rule between them is followed, i.e. a
+// topological sort with Kahn's algorithm restricted to the given nodes, in which each is
+// placed in their given order as soon as the rules allow. It returns a *CycleError when
+// no such order exists, or an error when any node is given more than once.
+func (p *Poset[N]) Sort(nodes []N) ([]N, error) {
+	index := make(map[N]int, len(nodes))
+	for i, n := range nodes {
+		if j, ok := index[n]; ok {
+			return nil, fmt.Errorf("node %v is given twice (at %d and %d)", n, j, i)
+		}
+		index[n] = i
+	}
+
+	// the number of rules that each node must come after, among the given nodes
+	inDegree := make([]int, len(nodes))
+	for _, n := range nodes {
+		for after := range p.after[n] {
+			if j, ok := index[after]; ok {
+				inDegree[j]++
+			}
+		}
+	}
+
+	// nodes which are ready to be placed, by their index in the given order
+	ready := &minHeap{}
+	for i, d := range inDegree {
+		if d == 0 {
+			*ready = append(*ready, i)
+		}
+	}
+	heap.Init(ready)
+
+	sorted := make([]N, 0, len(nodes))
+	for ready.Len() > 0 {
+		i := heap.Pop(ready).(int)
+		sorted = append(sorted, nodes[i])
+		for after := range p.after[nodes[i]] {
+			j, ok := index[after]
+			if !ok {
+				continue
+			}
+			if inDegree[j]--; inDegree[j] == 0 {
+				heap.Push(ready, j)
+			}
+		}
+	}
+
+	if len(sorted) < len(nodes) {
+		return nil, &CycleError[N]{Cycle: p.FindCycle(nodes)}
+	}
+	return sorted, nil
+}
+
+// minHeap is a min-heap of indices, for use with container/heap.
+type minHeap []int
+
+func (h minHeap) Len() int           { return len(h) }
+func (h minHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *minHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// FindCycle returns nodes among the given ones whose rules form a cycle (each
+// must come before the next, and the last before the first), or nil when none do.
+func (p *Poset[N]) FindCycle(nodes []N) []N {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := make(map[N]int, len(nodes))
+	for _, n := range nodes {
+		state[n] = unvisited
+	}
+
+	path := []N{}
+	var visit func(n N) []N
+	visit = func(n N) []N {
+		state[n] = onPath
+		path = append(path, n)
+		for after := range p.after[n] {
+			s, ok := state[after]
+			if !ok {
+				continue // not one of the given nodes
+			}
+			if s == onPath {
+				return slices.Clone(path[slices.Index(path, after):])
+			}
+			if s == unvisited {
+				if cycle := visit(after); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[n] = done
+		return nil
+	}
+
+	for _, n := range nodes {
+		if state[n] == unvisited {
+			if cycle := visit(n); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
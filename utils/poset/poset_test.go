@@ -0,0 +1,110 @@
+package poset
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// rules are the page ordering rules of the example of 2024 day 5.
+var rules = [][2]int{
+	{47, 53}, {97, 13}, {97, 61}, {97, 47}, {75, 29}, {61, 13}, {75, 53}, {29, 13}, {97, 29}, {53, 29}, {61, 53},
+	{97, 53}, {61, 29}, {47, 13}, {75, 47}, {97, 75}, {47, 61}, {75, 61}, {47, 29}, {75, 13}, {53, 13},
+}
+
+func examplePoset() *Poset[int] {
+	p := New[int]()
+	for _, r := range rules {
+		p.Add(r[0], r[1])
+	}
+	return p
+}
+
+func TestIsSorted(t *testing.T) {
+	p := examplePoset()
+	tests := []struct {
+		nodes []int
+		want  bool
+	}{
+		{[]int{75, 47, 61, 53, 29}, true},
+		{[]int{97, 61, 53, 29, 13}, true},
+		{[]int{75, 29, 13}, true},
+		{[]int{75, 97, 47, 61, 53}, false},
+		{[]int{61, 13, 29}, false},
+		{[]int{97, 13, 75, 29, 47}, false},
+		{[]int{}, true},
+	}
+	for _, test := range tests {
+		if got := p.IsSorted(test.nodes); got != test.want {
+			t.Errorf("IsSorted(%v) = %t, want %t", test.nodes, got, test.want)
+		}
+		if got := slices.IsSortedFunc(test.nodes, p.Compare); got != test.want {
+			t.Errorf("IsSortedFunc(%v, Compare) = %t, want %t", test.nodes, got, test.want)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	p := examplePoset()
+	tests := []struct {
+		nodes []int
+		want  []int
+		total bool // whether the rules order every pair of the nodes
+	}{
+		{[]int{75, 97, 47, 61, 53}, []int{97, 75, 47, 61, 53}, true},
+		{[]int{61, 13, 29}, []int{61, 29, 13}, true},
+		{[]int{97, 13, 75, 29, 47}, []int{97, 75, 47, 29, 13}, true},
+		// nodes are placed in their given order as soon as the rules allow
+		{[]int{1, 13, 2, 61, 3}, []int{1, 2, 61, 13, 3}, false},
+	}
+	for _, test := range tests {
+		got, err := p.Sort(test.nodes)
+		if err != nil {
+			t.Errorf("Sort(%v): unexpected error: %v", test.nodes, err)
+			continue
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("Sort(%v) = %v, want %v", test.nodes, got, test.want)
+		}
+		if test.total {
+			sorted := slices.Clone(test.nodes)
+			slices.SortFunc(sorted, p.Compare)
+			if !slices.Equal(sorted, test.want) {
+				t.Errorf("SortFunc(%v, Compare) = %v, want %v", test.nodes, sorted, test.want)
+			}
+		}
+	}
+}
+
+func TestSortDuplicates(t *testing.T) {
+	p := examplePoset()
+	if _, err := p.Sort([]int{75, 47, 75, 53}); err == nil {
+		t.Error("expected an error for a node given twice")
+	}
+}
+
+func TestCycle(t *testing.T) {
+	p := New[string]()
+	p.Add("a", "b")
+	p.Add("b", "c")
+	p.Add("c", "d")
+	p.Add("d", "b")
+
+	if cycle := p.FindCycle([]string{"a", "c", "d"}); cycle != nil {
+		t.Errorf("expected no cycle without b, got %v", cycle)
+	}
+
+	_, err := p.Sort([]string{"a", "b", "c", "d"})
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected a cycle error, got %v", err)
+	}
+	if len(cycleErr.Cycle) != 3 {
+		t.Fatalf("got cycle %v, want b, c and d", cycleErr.Cycle)
+	}
+	for i, n := range cycleErr.Cycle {
+		if next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]; !p.Before(n, next) {
+			t.Errorf("cycle %v has no rule %s|%s", cycleErr.Cycle, n, next)
+		}
+	}
+}
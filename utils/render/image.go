@@ -0,0 +1,93 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/png"
+	"io"
+	"slices"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+// Image returns the grid as an image in which every cell is a square of scale by
+// scale pixels in its color. The glyphs of the cells are not drawn. A paletted image
+// has at most 256 colors, so when there are more, each cell is drawn in the closest
+// color of the standard Plan 9 palette instead.
+func Image(g grid.Grid[byte], style Style, scale int, overlays ...Overlay) *image.Paletted {
+	scale = max(scale, 1)
+	resolved := cells(g, style, overlays)
+
+	// a paletted image (which GIFs require) needs every color up front
+	colors := color.Palette{}
+	for _, row := range resolved {
+		for _, c := range row {
+			if !slices.Contains(colors, c.color) {
+				colors = append(colors, c.color)
+			}
+		}
+	}
+	if len(colors) == 0 {
+		colors = append(colors, color.Black)
+	}
+	if len(colors) > 256 {
+		colors = slices.Clone(palette.Plan9)
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, g.Width()*scale, g.Height()*scale), colors)
+	for y, row := range resolved {
+		for x, c := range row {
+			index := uint8(colors.Index(c.color))
+			for py := y * scale; py < (y+1)*scale; py++ {
+				for px := x * scale; px < (x+1)*scale; px++ {
+					img.SetColorIndex(px, py, index)
+				}
+			}
+		}
+	}
+	return img
+}
+
+// PNG writes the grid to w as a PNG image, drawn as by Image.
+func PNG(w io.Writer, g grid.Grid[byte], style Style, scale int, overlays ...Overlay) error {
+	if err := png.Encode(w, Image(g, style, scale, overlays...)); err != nil {
+		return fmt.Errorf("failed to encode png: %v", err)
+	}
+	return nil
+}
+
+// Animation collects frames of a simulation into an animated GIF.
+type Animation struct {
+	Style Style
+	// Scale is the size of the square of pixels drawn for each cell.
+	Scale int
+	// Delay is how long each frame is shown for, in hundredths of a second.
+	Delay int
+
+	anim gif.GIF
+}
+
+// Frame adds the grid with the given overlays as the next frame of the animation.
+func (a *Animation) Frame(g grid.Grid[byte], overlays ...Overlay) {
+	a.anim.Image = append(a.anim.Image, Image(g, a.Style, a.Scale, overlays...))
+	a.anim.Delay = append(a.anim.Delay, a.Delay)
+}
+
+// Len returns the number of frames in the animation.
+func (a *Animation) Len() int {
+	return len(a.anim.Image)
+}
+
+// Encode writes the animation to w as a GIF.
+func (a *Animation) Encode(w io.Writer) error {
+	if a.Len() == 0 {
+		return fmt.Errorf("animation has no frames")
+	}
+	if err := gif.EncodeAll(w, &a.anim); err != nil {
+		return fmt.Errorf("failed to encode gif: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package render
+
+import (
+	"image/color"
+	"slices"
+	"strings"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+// Style says how to color the cells of a grid, by the byte in each.
+type Style struct {
+	// Colors are the colors of the bytes, where any byte without one has the Default color.
+	Colors map[byte]color.Color
+	// Default is the color of bytes without one in Colors, which is white when nil.
+	Default color.Color
+}
+
+// Overlay draws over some cells of a grid, e.g. to show a path or highlight a position.
+type Overlay struct {
+	Cells []grid.Coordinate
+	// Glyph replaces the byte in each cell (in text), unless it is 0.
+	Glyph byte
+	// Color replaces the color of each cell, unless it is nil.
+	Color color.Color
+}
+
+// cell is what is drawn for a single cell of a grid.
+type cell struct {
+	glyph byte
+	color color.Color
+}
+
+// Text returns the grid as text, one line per row. Unlike Grid.String, which
+// prints the bytes as numbers, it draws them as the characters they are.
+func Text(g grid.Grid[byte], overlays ...Overlay) string {
+	var sb strings.Builder
+	for _, row := range cells(g, Style{}, overlays) {
+		for _, c := range row {
+			sb.WriteByte(c.glyph)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// FromCoordinates returns a grid of the given size in which the given
+// coordinates are the glyph and every other cell is empty, e.g. to draw the
+// positions of robots. Coordinates outside the grid are left out.
+func FromCoordinates(coords []grid.Coordinate, width, height int, glyph, empty byte) grid.Grid[byte] {
+	g := make(grid.Grid[byte], height)
+	for y := range g {
+		g[y] = slices.Repeat([]byte{empty}, width)
+	}
+	for _, c := range coords {
+		g.Set(c, glyph)
+	}
+	return g
+}
+
+// cells resolves the glyph and color of every cell of the grid, with the overlays drawn in order.
+func cells(g grid.Grid[byte], style Style, overlays []Overlay) [][]cell {
+	def := style.Default
+	if def == nil {
+		def = color.White
+	}
+
+	out := make([][]cell, len(g))
+	for y, row := range g {
+		out[y] = make([]cell, len(row))
+		for x, b := range row {
+			c, ok := style.Colors[b]
+			if !ok {
+				c = def
+			}
+			out[y][x] = cell{glyph: b, color: c}
+		}
+	}
+	for _, overlay := range overlays {
+		for _, c := range overlay.Cells {
+			if !g.InBounds(c) {
+				continue
+			}
+			if overlay.Glyph != 0 {
+				out[c.Y][c.X].glyph = overlay.Glyph
+			}
+			if overlay.Color != nil {
+				out[c.Y][c.X].color = overlay.Color
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,139 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+var (
+	red  = color.RGBA{R: 255, A: 255}
+	blue = color.RGBA{B: 255, A: 255}
+)
+
+func testGrid(t *testing.T) grid.Grid[byte] {
+	t.Helper()
+
+	g, err := grid.ReadByte(strings.NewReader("#..\n.@.\n..#\n"))
+	if err != nil {
+		t.Fatalf("failed to read grid: %v", err)
+	}
+	return g
+}
+
+func TestText(t *testing.T) {
+	g := testGrid(t)
+	if got, want := Text(g), "#..\n.@.\n..#\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	path := Overlay{Cells: []grid.Coordinate{{X: 1, Y: 0}, {X: 2, Y: 0}, {X: 5, Y: 5}}, Glyph: '*'}
+	if got, want := Text(g, path), "#**\n.@.\n..#\n"; got != want {
+		t.Errorf("with overlay: got %q, want %q", got, want)
+	}
+
+	robots := FromCoordinates([]grid.Coordinate{{X: 0, Y: 1}, {X: 3, Y: 0}, {X: 9, Y: 9}}, 4, 2, '#', '.')
+	if got, want := Text(robots), "...#\n#...\n"; got != want {
+		t.Errorf("from coordinates: got %q, want %q", got, want)
+	}
+}
+
+func TestANSI(t *testing.T) {
+	g := testGrid(t)
+	style := Style{Colors: map[byte]color.Color{'#': blue}}
+	got := ANSI(g, style, Overlay{Cells: []grid.Coordinate{{X: 1, Y: 1}}, Color: red})
+
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(lines))
+	}
+	if want := "\x1b[38;2;0;0;255m#\x1b[38;2;255;255;255m..\x1b[0m"; lines[0] != want {
+		t.Errorf("got first line %q, want %q", lines[0], want)
+	}
+	if want := "\x1b[38;2;255;0;0m@"; !strings.Contains(lines[1], want) {
+		t.Errorf("expected the highlighted cell in red, got %q", lines[1])
+	}
+}
+
+func TestImage(t *testing.T) {
+	g := testGrid(t)
+	style := Style{Colors: map[byte]color.Color{'#': blue}, Default: color.Black}
+	img := Image(g, style, 2, Overlay{Cells: []grid.Coordinate{{X: 1, Y: 1}}, Color: red})
+
+	if b := img.Bounds(); b.Dx() != 6 || b.Dy() != 6 {
+		t.Fatalf("got a %dx%d image, want 6x6", b.Dx(), b.Dy())
+	}
+	for _, test := range []struct {
+		x, y int
+		want color.Color
+	}{
+		{0, 0, blue}, {1, 1, blue}, {2, 0, color.Black}, {2, 2, red}, {3, 3, red}, {5, 5, blue},
+	} {
+		if got := img.At(test.x, test.y); !sameColor(got, test.want) {
+			t.Errorf("got %v at (%d,%d), want %v", got, test.x, test.y, test.want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := PNG(&buf, g, style, 1); err != nil {
+		t.Fatalf("failed to write png: %v", err)
+	}
+	if decoded, err := png.Decode(&buf); err != nil || decoded.Bounds().Dx() != 3 {
+		t.Errorf("failed to decode the png (%v)", err)
+	}
+}
+
+func TestImageManyColors(t *testing.T) {
+	// a cell in each of 300 shades of gray, more than a paletted image can hold
+	g := FromCoordinates(nil, 300, 1, '#', '.')
+	overlays := []Overlay{}
+	for x := 0; x < 300; x++ {
+		overlays = append(overlays, Overlay{Cells: []grid.Coordinate{{X: x}}, Color: color.Gray16{Y: uint16(x * 218)}})
+	}
+	img := Image(g, Style{}, 1, overlays...)
+	if len(img.Palette) > 256 {
+		t.Fatalf("got a palette of %d colors, want at most 256", len(img.Palette))
+	}
+	for x := 0; x < 300; x += 37 {
+		want := color.Palette(palette.Plan9).Convert(color.Gray16{Y: uint16(x * 218)})
+		if got := img.At(x, 0); !sameColor(got, want) {
+			t.Errorf("got %v at x=%d, want the closest color %v", got, x, want)
+		}
+	}
+}
+
+func TestAnimation(t *testing.T) {
+	g := testGrid(t)
+	anim := &Animation{Scale: 1, Delay: 5}
+
+	var buf bytes.Buffer
+	if err := anim.Encode(&buf); err == nil {
+		t.Error("expected an error for an animation without frames")
+	}
+
+	for x := 0; x < 3; x++ {
+		anim.Frame(g, Overlay{Cells: []grid.Coordinate{{X: x, Y: 1}}, Color: red})
+	}
+	if err := anim.Encode(&buf); err != nil {
+		t.Fatalf("failed to write gif: %v", err)
+	}
+	decoded, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("failed to decode the gif: %v", err)
+	}
+	if len(decoded.Image) != 3 || decoded.Delay[0] != 5 {
+		t.Errorf("got %d frames with delay %d, want 3 with delay 5", len(decoded.Image), decoded.Delay[0])
+	}
+}
+
+func sameColor(a, b color.Color) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	return ar == br && ag == bg && ab == bb && aa == ba
+}
@@ -0,0 +1,52 @@
+package render
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/adrianosela/adventofcode/utils/grid"
+)
+
+const (
+	ansiReset = "\x1b[0m"
+	// ansiHome moves the cursor to the top left and clears the screen
+	ansiHome = "\x1b[H\x1b[2J"
+)
+
+// ANSI returns the grid as text colored with (24-bit) ANSI escape codes for terminals.
+func ANSI(g grid.Grid[byte], style Style, overlays ...Overlay) string {
+	var sb strings.Builder
+	for _, row := range cells(g, style, overlays) {
+		var last color.Color
+		for _, c := range row {
+			if c.color != last {
+				r, g, b, _ := c.color.RGBA()
+				fmt.Fprintf(&sb, "\x1b[38;2;%d;%d;%dm", r>>8, g>>8, b>>8)
+				last = c.color
+			}
+			sb.WriteByte(c.glyph)
+		}
+		sb.WriteString(ansiReset + "\n")
+	}
+	return sb.String()
+}
+
+// Terminal draws frames of a simulation in a terminal, each replacing the last.
+type Terminal struct {
+	Out   io.Writer
+	Style Style
+	// Delay is how long each frame is shown for, such that the simulation can be followed.
+	Delay time.Duration
+}
+
+// Frame clears the terminal and draws the grid with the given overlays.
+func (t *Terminal) Frame(g grid.Grid[byte], overlays ...Overlay) error {
+	if _, err := io.WriteString(t.Out, ansiHome+ANSI(g, t.Style, overlays...)); err != nil {
+		return fmt.Errorf("failed to write frame: %v", err)
+	}
+	time.Sleep(t.Delay)
+	return nil
+}
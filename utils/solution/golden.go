@@ -0,0 +1,60 @@
+package solution
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Golden is the known correct answer to one part of a puzzle for one input file.
+type Golden struct {
+	Input  string
+	Part   int
+	Answer string
+}
+
+// LoadGolden loads the golden answers in the given file. Every line holds an input
+// filename (relative to the puzzle's directory), a part and the expected answer,
+// separated by whitespace, e.g. "sample-input.txt 1 480". Blank lines and lines
+// starting with "#" are ignored.
+func LoadGolden(filename string) ([]Golden, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open answers file: %v", err)
+	}
+	defer file.Close()
+
+	golden := []Golden{}
+
+	lineNo := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("invalid answer on line %d: expected \"<input> <part> <answer>\", got \"%s\"", lineNo, line)
+		}
+		part, err := strconv.Atoi(fields[1])
+		if err != nil || (part != 1 && part != 2) {
+			return nil, fmt.Errorf("invalid part \"%s\" on line %d (must be 1 or 2)", fields[1], lineNo)
+		}
+
+		golden = append(golden, Golden{
+			Input:  fields[0],
+			Part:   part,
+			Answer: strings.Join(fields[2:], " "),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read answers file: %v", err)
+	}
+
+	return golden, nil
+}
@@ -0,0 +1,121 @@
+package solution
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	// ErrUnsolved is returned by solvers for puzzle parts that have not been solved yet.
+	ErrUnsolved = errors.New("puzzle part not solved yet")
+	// ErrNoPart2 is returned by solvers for puzzles without a second part (i.e. day 25).
+	ErrNoPart2 = errors.New("puzzle has no second part")
+)
+
+// Answer is the answer to one part of a puzzle, printed with %v.
+type Answer any
+
+// Input is a parsed puzzle input whose type is only known to its Puzzle.
+type Input any
+
+// Solution is implemented by the solution to every puzzle. Parse turns the raw
+// puzzle input into a T which is then handed to Part1 and Part2. The parts must
+// not modify their input, such that a single parsed input can be solved (and
+// measured) any number of times.
+type Solution[T any] interface {
+	Parse(r io.Reader) (T, error)
+	Part1(in T) (Answer, error)
+	Part2(in T) (Answer, error)
+}
+
+// Puzzle is a Solution with the type of its parsed input erased, such that
+// the runner, tests and benchmarks can handle every puzzle uniformly.
+type Puzzle interface {
+	Parse(r io.Reader) (Input, error)
+	Part1(in Input) (Answer, error)
+	Part2(in Input) (Answer, error)
+}
+
+// Flagger is implemented by solutions which can be tuned with command line flags.
+type Flagger interface {
+	Flags(fs *flag.FlagSet)
+}
+
+// Erase returns the given solution as a Puzzle.
+func Erase[T any](s Solution[T]) Puzzle {
+	return &erased[T]{solution: s}
+}
+
+type erased[T any] struct {
+	solution Solution[T]
+}
+
+func (e *erased[T]) Parse(r io.Reader) (Input, error) {
+	return e.solution.Parse(r)
+}
+
+func (e *erased[T]) Part1(in Input) (Answer, error) {
+	typed, err := e.typed(in)
+	if err != nil {
+		return nil, err
+	}
+	return e.solution.Part1(typed)
+}
+
+func (e *erased[T]) Part2(in Input) (Answer, error) {
+	typed, err := e.typed(in)
+	if err != nil {
+		return nil, err
+	}
+	return e.solution.Part2(typed)
+}
+
+func (e *erased[T]) typed(in Input) (T, error) {
+	typed, ok := in.(T)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("input of type %T was not parsed by this puzzle (expected %T)", in, zero)
+	}
+	return typed, nil
+}
+
+func (e *erased[T]) unwrap() any {
+	return e.solution
+}
+
+// FlaggerOf returns the Flagger of the given puzzle's
+// solution, if the solution takes command line flags.
+func FlaggerOf(p Puzzle) (Flagger, bool) {
+	if e, ok := p.(interface{ unwrap() any }); ok {
+		f, ok := e.unwrap().(Flagger)
+		return f, ok
+	}
+	f, ok := p.(Flagger)
+	return f, ok
+}
+
+// ParseFile parses the puzzle input in the given file.
+func ParseFile(p Puzzle, filename string) (Input, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open input file: %v", err)
+	}
+	defer file.Close()
+
+	return p.Parse(file)
+}
+
+// Solve solves the given part (1 or 2) of a puzzle for a parsed input.
+func Solve(p Puzzle, in Input, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return p.Part1(in)
+	case 2:
+		return p.Part2(in)
+	default:
+		return nil, fmt.Errorf("invalid part %d (must be 1 or 2)", part)
+	}
+}
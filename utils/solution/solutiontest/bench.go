@@ -0,0 +1,79 @@
+package solutiontest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// BenchInput is the input file solutions are benchmarked against.
+const BenchInput = "input.txt"
+
+// Benchmark benchmarks parsing the puzzle's input and solving every part of it
+// which has a golden answer, such that slow or unsolved parts are left out. It is
+// skipped until there is an input file with a golden answer (e.g. for a new puzzle).
+func Benchmark(b *testing.B, p solution.Puzzle) {
+	golden, err := solution.LoadGolden(AnswersFile)
+	if err != nil {
+		b.Fatalf("failed to load golden answers: %v", err)
+	}
+	parts := Parts(golden, BenchInput)
+	if len(parts) == 0 {
+		b.Skipf("no golden answers for %s in %s yet", BenchInput, AnswersFile)
+	}
+	data, err := os.ReadFile(BenchInput)
+	if errors.Is(err, os.ErrNotExist) {
+		b.Skipf("no %s to benchmark yet", BenchInput)
+	}
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	in, err := p.Parse(bytes.NewReader(data))
+	if err != nil {
+		b.Fatalf("failed to parse %s: %v", BenchInput, err)
+	}
+
+	b.Run("parse", BenchmarkParse(p, data))
+	for _, part := range parts {
+		b.Run(fmt.Sprintf("part-%d", part), BenchmarkPart(p, in, part))
+	}
+}
+
+// BenchmarkParse returns a benchmark of parsing the given puzzle input.
+func BenchmarkParse(p solution.Puzzle, data []byte) func(b *testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := p.Parse(bytes.NewReader(data)); err != nil {
+				b.Fatalf("failed to parse input: %v", err)
+			}
+		}
+	}
+}
+
+// BenchmarkPart returns a benchmark of solving the given part for a parsed puzzle input.
+func BenchmarkPart(p solution.Puzzle, in solution.Input, part int) func(b *testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := solution.Solve(p, in, part); err != nil {
+				b.Fatalf("failed to solve part %d: %v", part, err)
+			}
+		}
+	}
+}
+
+// Parts returns the parts with a golden answer for the given input file.
+func Parts(golden []solution.Golden, input string) []int {
+	parts := []int{}
+	for _, g := range golden {
+		if g.Input == input {
+			parts = append(parts, g.Part)
+		}
+	}
+	return parts
+}
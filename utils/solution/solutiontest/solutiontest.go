@@ -0,0 +1,50 @@
+package solutiontest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/adrianosela/adventofcode/utils/solution"
+)
+
+// AnswersFile is the name of the file with a puzzle's golden answers,
+// kept in the puzzle's directory alongside its input files.
+const AnswersFile = "answers.txt"
+
+// Golden checks the puzzle against every answer in the answers file of the
+// current directory, which is the puzzle's directory when run by go test.
+func Golden(t *testing.T, p solution.Puzzle) {
+	t.Helper()
+
+	golden, err := solution.LoadGolden(AnswersFile)
+	if err != nil {
+		t.Fatalf("failed to load golden answers: %v", err)
+	}
+	if len(golden) == 0 {
+		t.Skipf("no golden answers in %s yet", AnswersFile)
+	}
+
+	for _, g := range golden {
+		t.Run(fmt.Sprintf("%s/part-%d", g.Input, g.Part), func(t *testing.T) {
+			Check(t, p, g.Input, g.Part, g.Answer)
+		})
+	}
+}
+
+// Check checks the puzzle's answer to the given part for the input in the given
+// file, compared to the wanted answer in its printed (i.e. %v) form.
+func Check(t *testing.T, p solution.Puzzle, filename string, part int, want string) {
+	t.Helper()
+
+	in, err := solution.ParseFile(p, filename)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", filename, err)
+	}
+	answer, err := solution.Solve(p, in, part)
+	if err != nil {
+		t.Fatalf("failed to solve part %d for %s: %v", part, filename, err)
+	}
+	if got := fmt.Sprint(answer); got != want {
+		t.Errorf("wrong answer to part %d for %s: got %s, want %s", part, filename, got, want)
+	}
+}